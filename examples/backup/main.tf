@@ -0,0 +1,30 @@
+provider "pinecone" {
+  # will use PINECONE_API_KEY
+  # and PINECONE_ENVIRONMENT env vars
+}
+
+resource "pinecone_backup" "nightly" {
+  source_index_name = var.index_name
+  name              = "${var.index_name}-nightly"
+  description       = "Backup taken before reindexing"
+}
+
+# waits until the restore job has populated the index
+resource "pinecone_index" "restored" {
+  name          = "${var.index_name}-restored"
+  source_backup = pinecone_backup.nightly.id
+}
+
+data "pinecone_backups" "all" {
+  index_name = var.index_name
+
+  depends_on = [pinecone_backup.nightly]
+}
+
+output "backup_record_count" {
+  value = pinecone_backup.nightly.record_count
+}
+
+output "backups" {
+  value = [for backup in data.pinecone_backups.all.backups : backup.name]
+}
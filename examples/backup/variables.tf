@@ -0,0 +1,4 @@
+variable "index_name" {
+  type        = string
+  description = "The serverless index to back up"
+}
@@ -0,0 +1,23 @@
+provider "pinecone" {
+  # will use PINECONE_API_KEY
+  # and PINECONE_ENVIRONMENT env vars
+}
+
+data "pinecone_capacity_plan" "embeddings" {
+  vector_count  = var.vector_count
+  dimension     = 1536
+  metadata_size = 512
+  qps           = var.qps
+}
+
+resource "pinecone_index" "embeddings" {
+  name      = "embeddings"
+  dimension = 1536
+  pod_type  = data.pinecone_capacity_plan.embeddings.pod_type
+  pods      = data.pinecone_capacity_plan.embeddings.pods
+  replicas  = data.pinecone_capacity_plan.embeddings.replicas
+}
+
+output "estimated_monthly_cost" {
+  value = pinecone_index.embeddings.estimated_monthly_cost
+}
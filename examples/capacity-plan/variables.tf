@@ -0,0 +1,11 @@
+variable "vector_count" {
+  type        = number
+  description = "The number of vectors the index is expected to hold"
+  default     = 5000000
+}
+
+variable "qps" {
+  type        = number
+  description = "The queries per second the index is expected to serve"
+  default     = 40
+}
@@ -12,7 +12,6 @@ resource "pinecone_index" "my-first-index" {
   metric = "cosine"
   pods   = 1
 
+  # dimension defaults to the dimension of the collection
   source_collection = data.pinecone_collection.existing-collection.name
-  # index and collection dimension must match
-  dimension = data.pinecone_collection.existing-collection.dimension
 }
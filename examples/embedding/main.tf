@@ -0,0 +1,35 @@
+provider "pinecone" {
+  # will use PINECONE_API_KEY
+  # and PINECONE_ENVIRONMENT env vars
+}
+
+data "pinecone_models" "e5" {
+  name = "multilingual-e5-large"
+}
+
+data "pinecone_embedding" "seed" {
+  model      = data.pinecone_models.e5.models[0].name
+  input_type = "passage"
+  inputs     = ["The quick brown fox jumps over the lazy dog"]
+}
+
+resource "pinecone_index" "smoke" {
+  name      = "smoke"
+  dimension = data.pinecone_models.e5.models[0].default_dimension
+}
+
+resource "pinecone_vector" "seed" {
+  index_name = pinecone_index.smoke.name
+  vector_id  = "fox"
+  values     = data.pinecone_embedding.seed.embeddings[0]
+  metadata = {
+    text = "The quick brown fox jumps over the lazy dog"
+  }
+}
+
+check "embedding_matches_index" {
+  assert {
+    condition     = data.pinecone_embedding.seed.dimension == pinecone_index.smoke.dimension
+    error_message = "The embedding model and the index have different dimensions."
+  }
+}
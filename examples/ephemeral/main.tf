@@ -0,0 +1,27 @@
+provider "pinecone" {
+  # will use PINECONE_API_KEY
+  # and PINECONE_ENVIRONMENT env vars
+}
+
+resource "pinecone_index" "movies" {
+  name      = "movies"
+  dimension = 1536
+}
+
+# neither the api key nor the host is written to the plan or state
+ephemeral "pinecone_credentials" "movies" {
+  index_name = pinecone_index.movies.name
+}
+
+resource "aws_secretsmanager_secret" "movies" {
+  name = "movies-pinecone"
+}
+
+resource "aws_secretsmanager_secret_version" "movies" {
+  secret_id = aws_secretsmanager_secret.movies.id
+  secret_string_wo = jsonencode({
+    PINECONE_API_KEY = ephemeral.pinecone_credentials.movies.api_key
+    PINECONE_HOST    = ephemeral.pinecone_credentials.movies.host
+  })
+  secret_string_wo_version = var.secret_version
+}
@@ -0,0 +1,14 @@
+terraform {
+  # ephemeral resources and write-only arguments
+  required_version = ">= 1.11"
+
+  required_providers {
+    pinecone = {
+      source = "thekevinwang.com/terraform-providers/pinecone"
+    }
+    aws = {
+      source  = "hashicorp/aws"
+      version = ">= 5.90"
+    }
+  }
+}
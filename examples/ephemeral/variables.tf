@@ -0,0 +1,5 @@
+variable "secret_version" {
+  type        = number
+  description = "Bump to write the current API key and host to the secret again"
+  default     = 1
+}
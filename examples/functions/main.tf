@@ -0,0 +1,22 @@
+provider "pinecone" {
+  # will use PINECONE_API_KEY
+  # and PINECONE_ENVIRONMENT env vars
+}
+
+data "pinecone_whoami" "current" {}
+
+resource "pinecone_index" "movies" {
+  name      = "movies"
+  dimension = 1536
+  pod_type  = provider::pinecone::pod_type("p1", var.pod_size)
+
+  metadata_config = provider::pinecone::metadata_config(concat(["tenant_id"], var.indexed_metadata))
+}
+
+output "index_environment" {
+  value = provider::pinecone::parse_index_id(pinecone_index.movies.id).environment
+}
+
+output "index_url" {
+  value = provider::pinecone::index_host_url(pinecone_index.movies.name, data.pinecone_whoami.current.project_name, data.pinecone_whoami.current.environment)
+}
@@ -0,0 +1,10 @@
+terraform {
+  # provider-defined functions
+  required_version = ">= 1.8"
+
+  required_providers {
+    pinecone = {
+      source = "thekevinwang.com/terraform-providers/pinecone"
+    }
+  }
+}
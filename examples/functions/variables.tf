@@ -0,0 +1,11 @@
+variable "pod_size" {
+  type        = string
+  description = "The size of the pods of the index, ex. x2"
+  default     = "x1"
+}
+
+variable "indexed_metadata" {
+  type        = list(string)
+  description = "Metadata fields to index, in addition to the ones every index needs"
+  default     = ["genre"]
+}
@@ -0,0 +1,16 @@
+provider "pinecone" {
+  # will use PINECONE_API_KEY
+  # and PINECONE_ENVIRONMENT env vars
+}
+
+# waits until every record is imported, and fails the apply if the import fails
+resource "pinecone_import" "movies" {
+  index_name     = var.index_name
+  uri            = var.uri
+  integration_id = var.integration_id
+  error_mode     = "continue"
+}
+
+output "records_imported" {
+  value = pinecone_import.movies.records_imported
+}
@@ -0,0 +1,15 @@
+variable "index_name" {
+  type        = string
+  description = "The serverless index to import the records into"
+}
+
+variable "uri" {
+  type        = string
+  description = "The bucket prefix holding the Parquet files, ex. s3://bucket/movies/"
+}
+
+variable "integration_id" {
+  type        = string
+  description = "The storage integration that grants access to a private bucket"
+  default     = null
+}
@@ -0,0 +1,31 @@
+provider "pinecone" {
+  # will use PINECONE_API_KEY
+  # and PINECONE_ENVIRONMENT env vars
+}
+
+# the dimension and metric are taken from the model
+resource "pinecone_index" "articles" {
+  name = "articles"
+
+  embed = {
+    model  = "multilingual-e5-large"
+    cloud  = "aws"
+    region = "us-east-1"
+
+    field_map = {
+      text = "chunk_text"
+    }
+    read_parameters = {
+      input_type = "query"
+      truncate   = "END"
+    }
+    write_parameters = {
+      input_type = "passage"
+      truncate   = "END"
+    }
+  }
+}
+
+output "dimension" {
+  value = pinecone_index.articles.dimension
+}
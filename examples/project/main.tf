@@ -0,0 +1,36 @@
+provider "pinecone" {
+  # will use PINECONE_CLIENT_ID, PINECONE_CLIENT_SECRET
+  # and PINECONE_ENVIRONMENT env vars
+}
+
+resource "pinecone_project" "env" {
+  for_each = var.environments
+
+  name     = each.key
+  max_pods = 10
+}
+
+resource "pinecone_api_key" "search" {
+  for_each = pinecone_project.env
+
+  project_id = each.value.id
+  name       = "search-service"
+  roles      = ["DataPlaneViewer"]
+}
+
+output "project_ids" {
+  value = { for env, project in pinecone_project.env : env => project.id }
+}
+
+# Indexes are managed by a provider scoped to the project. The project id must
+# be known when the provider is configured, so create the projects first, ex.
+# in this configuration, and the indexes in a configuration per environment:
+#
+# provider "pinecone" {
+#   project_id = "<project_ids[\"staging\"]>"
+# }
+#
+# resource "pinecone_index" "movies" {
+#   name      = "movies"
+#   dimension = 1536
+# }
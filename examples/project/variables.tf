@@ -0,0 +1,5 @@
+variable "environments" {
+  type        = set(string)
+  description = "A project is created for each environment"
+  default     = ["staging", "production"]
+}
@@ -0,0 +1,19 @@
+provider "pinecone" {
+  # will use PINECONE_API_KEY
+  # and PINECONE_ENVIRONMENT env vars
+}
+
+data "pinecone_whoami" "current" {
+  lifecycle {
+    postcondition {
+      condition     = self.project_name == var.pinecone_project_name
+      error_message = "The API key belongs to project ${self.project_name}, expected ${var.pinecone_project_name}."
+    }
+  }
+}
+
+resource "pinecone_index" "my-first-index" {
+  # fail before creating anything if pointed at the wrong project
+  name      = "testidx-${data.pinecone_whoami.current.user_label}"
+  dimension = 1536
+}
@@ -0,0 +1,7 @@
+terraform {
+  required_providers {
+    pinecone = {
+      source = "thekevinwang.com/terraform-providers/pinecone"
+    }
+  }
+}
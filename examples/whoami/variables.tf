@@ -0,0 +1,4 @@
+variable "pinecone_project_name" {
+  type        = string
+  description = "The project this configuration is expected to manage"
+}
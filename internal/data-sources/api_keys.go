@@ -0,0 +1,146 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ApiKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &ApiKeysDataSource{}
+)
+
+func NewApiKeysDataSource() datasource.DataSource {
+	return &ApiKeysDataSource{}
+}
+
+// ApiKeysDataSource defines the data source implementation.
+type ApiKeysDataSource struct {
+	client services.AdminPlane
+}
+
+// ApiKeysDataSourceModel describes the data source data model.
+type ApiKeysDataSourceModel struct {
+	ProjectId types.String  `tfsdk:"project_id"`
+	ApiKeys   []apiKeyModel `tfsdk:"api_keys"`
+	Id        types.String  `tfsdk:"id"`
+}
+
+type apiKeyModel struct {
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Roles types.List   `tfsdk:"roles"`
+}
+
+func (d *ApiKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_keys"
+}
+
+func (d *ApiKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `The API keys of a project, without their secret values. Requires the provider's ` + "`client_id` and `client_secret`" + `.
+- See [API Docs](https://docs.pinecone.io/reference/api/2025-04/admin/list_api_keys)
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the project to list the API keys of",
+				Required:            true,
+			},
+			"api_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The API keys of the project",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The id of the API key",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the API key",
+							Computed:            true,
+						},
+						"roles": schema.ListAttribute{
+							MarkdownDescription: "The roles granted to the API key",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Example identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the datasource
+func (d *ApiKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(services.AdminPlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.AdminPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApiKeysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := d.client.ListApiKeys(data.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list API keys",
+			fmt.Sprintf("Failed to list API keys: %s", err),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "ListApiKeys OK", map[string]any{"count": len(keys)})
+
+	data.ApiKeys = []apiKeyModel{}
+	for _, key := range keys {
+		roles, diags := types.ListValueFrom(ctx, types.StringType, key.Roles)
+		resp.Diagnostics.Append(diags...)
+		data.ApiKeys = append(data.ApiKeys, apiKeyModel{
+			Id:    types.StringValue(key.Id),
+			Name:  types.StringValue(key.Name),
+			Roles: roles,
+		})
+	}
+	data.Id = types.StringValue(fmt.Sprintf("datasource-pinecone_api_keys-%s", data.ProjectId.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
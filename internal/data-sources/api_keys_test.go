@@ -0,0 +1,63 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeAdminPlane lists the API keys of a project from memory.
+type fakeAdminPlane struct {
+	services.AdminPlane
+	apiKeys map[string][]services.ApiKey
+}
+
+func (f *fakeAdminPlane) ListApiKeys(projectId string) ([]services.ApiKey, error) {
+	return f.apiKeys[projectId], nil
+}
+
+func TestApiKeysDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeAdminPlane{apiKeys: map[string][]services.ApiKey{
+		"proj": {
+			{Id: "k1", Name: "search", ProjectId: "proj", Roles: []string{"DataPlaneViewer", "ControlPlaneViewer"}},
+			{Id: "k2", Name: "default", ProjectId: "proj", Roles: []string{"ProjectEditor"}},
+		},
+	}}
+
+	read := func(projectId string) ApiKeysDataSourceModel {
+		t.Helper()
+		readResp := readDataSource(t, NewApiKeysDataSource(), fake, map[string]tftypes.Value{
+			"project_id": tftypes.NewValue(tftypes.String, projectId),
+		})
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+		}
+
+		var state ApiKeysDataSourceModel
+		readResp.State.Get(ctx, &state)
+		return state
+	}
+
+	got := read("proj")
+	if len(got.ApiKeys) != 2 || got.ApiKeys[0].Id.ValueString() != "k1" || got.ApiKeys[0].Name.ValueString() != "search" {
+		t.Fatalf("unexpected API keys %+v", got.ApiKeys)
+	}
+	var roles []string
+	got.ApiKeys[0].Roles.ElementsAs(ctx, &roles, false)
+	if len(roles) != 2 || roles[0] != "DataPlaneViewer" || roles[1] != "ControlPlaneViewer" {
+		t.Errorf("unexpected roles %v", roles)
+	}
+	if got.Id.ValueString() != "datasource-pinecone_api_keys-proj" {
+		t.Errorf("unexpected id %q", got.Id.ValueString())
+	}
+
+	// a project without keys has an empty list rather than a null one
+	empty := read("other")
+	if empty.ApiKeys == nil || len(empty.ApiKeys) != 0 {
+		t.Errorf("expected no API keys, got %+v", empty.ApiKeys)
+	}
+}
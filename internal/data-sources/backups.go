@@ -0,0 +1,167 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &BackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &BackupsDataSource{}
+)
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
+
+// BackupsDataSource defines the data source implementation.
+type BackupsDataSource struct {
+	client services.BackupPlane
+}
+
+// BackupsDataSourceModel describes the data source data model.
+type BackupsDataSourceModel struct {
+	IndexName types.String  `tfsdk:"index_name"`
+	Backups   []backupModel `tfsdk:"backups"`
+	Id        types.String  `tfsdk:"id"`
+}
+
+type backupModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	SourceIndexName types.String `tfsdk:"source_index_name"`
+	Status          types.String `tfsdk:"status"`
+	Dimension       types.Int64  `tfsdk:"dimension"`
+	RecordCount     types.Int64  `tfsdk:"record_count"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+func (d *BackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backups"
+}
+
+func (d *BackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `The backups of a serverless index, or of every index in the project.
+- See [API Docs](https://docs.pinecone.io/reference/api/2025-04/control-plane/list_project_backups)
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"index_name": schema.StringAttribute{
+				MarkdownDescription: "The index to list the backups of. When omitted, the backups of the whole project are listed.",
+				Optional:            true,
+			},
+			"backups": schema.ListNestedAttribute{
+				MarkdownDescription: "The backups",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The id of the backup",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the backup",
+							Computed:            true,
+						},
+						"source_index_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the backed up index",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the backup, ex. `Ready`",
+							Computed:            true,
+						},
+						"dimension": schema.Int64Attribute{
+							MarkdownDescription: "The dimension of the vectors in the backup",
+							Computed:            true,
+						},
+						"record_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of records in the backup",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the backup was created, as an RFC 3339 timestamp",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Example identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the datasource
+func (d *BackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(services.BackupPlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.BackupPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backups, err := d.client.ListBackups(data.IndexName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list backups",
+			fmt.Sprintf("Failed to list backups: %s", err),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "ListBackups OK", map[string]any{"count": len(backups)})
+
+	data.Backups = []backupModel{}
+	for _, backup := range backups {
+		data.Backups = append(data.Backups, backupModel{
+			Id:              types.StringValue(backup.Id),
+			Name:            types.StringValue(backup.Name),
+			SourceIndexName: types.StringValue(backup.SourceIndexName),
+			Status:          types.StringValue(backup.Status),
+			Dimension:       types.Int64Value(backup.Dimension),
+			RecordCount:     types.Int64Value(backup.RecordCount),
+			CreatedAt:       types.StringValue(backup.CreatedAt),
+		})
+	}
+	data.Id = types.StringValue(fmt.Sprintf("datasource-pinecone_backups-%s", data.IndexName.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,65 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeBackupPlane lists backups from memory, filtered by index.
+type fakeBackupPlane struct {
+	services.BackupPlane
+	backups []services.Backup
+}
+
+func (f *fakeBackupPlane) ListBackups(indexName string) ([]services.Backup, error) {
+	var backups []services.Backup
+	for _, backup := range f.backups {
+		if indexName == "" || backup.SourceIndexName == indexName {
+			backups = append(backups, backup)
+		}
+	}
+	return backups, nil
+}
+
+func TestBackupsDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeBackupPlane{backups: []services.Backup{
+		{Id: "b1", Name: "nightly", SourceIndexName: "movies", Status: "Ready", Dimension: 1536, RecordCount: 1000},
+		{Id: "b2", SourceIndexName: "books", Status: "Initializing", Dimension: 768},
+	}}
+
+	read := func(indexName interface{}) BackupsDataSourceModel {
+		t.Helper()
+		readResp := readDataSource(t, NewBackupsDataSource(), fake, map[string]tftypes.Value{
+			"index_name": tftypes.NewValue(tftypes.String, indexName),
+		})
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+		}
+
+		var state BackupsDataSourceModel
+		readResp.State.Get(ctx, &state)
+		return state
+	}
+
+	all := read(nil)
+	if len(all.Backups) != 2 {
+		t.Errorf("expected the backups of the project, got %+v", all.Backups)
+	}
+
+	movies := read("movies")
+	if len(movies.Backups) != 1 {
+		t.Fatalf("expected the backups of the index, got %+v", movies.Backups)
+	}
+	got := movies.Backups[0]
+	if got.Id.ValueString() != "b1" || got.Name.ValueString() != "nightly" || got.Dimension.ValueInt64() != 1536 || got.RecordCount.ValueInt64() != 1000 {
+		t.Errorf("unexpected backup %+v", got)
+	}
+	if movies.Id.ValueString() != "datasource-pinecone_backups-movies" {
+		t.Errorf("unexpected id %q", movies.Id.ValueString())
+	}
+}
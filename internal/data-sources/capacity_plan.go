@@ -0,0 +1,176 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &CapacityPlanDataSource{}
+	_ datasource.DataSourceWithConfigure = &CapacityPlanDataSource{}
+)
+
+func NewCapacityPlanDataSource() datasource.DataSource {
+	return &CapacityPlanDataSource{}
+}
+
+// CapacityPlanDataSource defines the data source implementation.
+type CapacityPlanDataSource struct {
+	prices *services.PriceTable
+}
+
+// CapacityPlanDataSourceModel describes the data source data model.
+type CapacityPlanDataSourceModel struct {
+	VectorCount          types.Int64   `tfsdk:"vector_count"`
+	Dimension            types.Int64   `tfsdk:"dimension"`
+	MetadataSize         types.Int64   `tfsdk:"metadata_size"`
+	QPS                  types.Float64 `tfsdk:"qps"`
+	PodTypeConstraint    types.String  `tfsdk:"pod_type_constraint"`
+	PodType              types.String  `tfsdk:"pod_type"`
+	Pods                 types.Int64   `tfsdk:"pods"`
+	Replicas             types.Int64   `tfsdk:"replicas"`
+	Shards               types.Int64   `tfsdk:"shards"`
+	VectorsPerPod        types.Int64   `tfsdk:"vectors_per_pod"`
+	EstimatedMonthlyCost types.Float64 `tfsdk:"estimated_monthly_cost"`
+	Id                   types.String  `tfsdk:"id"`
+}
+
+func (d *CapacityPlanDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_capacity_plan"
+}
+
+func (d *CapacityPlanDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Recommends the pod configuration of an index for a workload, so that modules can size indexes declaratively.
+
+The recommendation is the cheapest configuration that fits the vectors and serves the requested QPS, according to Pinecone's published per-pod capacities and the provider's price table. Capacities vary with the data, so verify the recommendation with a load test before relying on it.
+- See [Choosing index type and size](https://docs.pinecone.io/docs/choosing-index-type-and-size)
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"vector_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of vectors the index must hold",
+				Required:            true,
+			},
+			"dimension": schema.Int64Attribute{
+				MarkdownDescription: "The dimension of the vectors",
+				Required:            true,
+			},
+			"metadata_size": schema.Int64Attribute{
+				MarkdownDescription: "The average size of the metadata of a vector, in bytes. Defaults to `0`.",
+				Optional:            true,
+			},
+			"qps": schema.Float64Attribute{
+				MarkdownDescription: "The queries per second the index must serve. Defaults to `0`, ie. a single replica.",
+				Optional:            true,
+			},
+			"pod_type_constraint": schema.StringAttribute{
+				MarkdownDescription: "Only consider this pod type, ex. `p1.x2`, or pod family, ex. `s1`. All pod types are considered if not set.",
+				Optional:            true,
+			},
+			"pod_type": schema.StringAttribute{
+				MarkdownDescription: "The recommended pod type, ex. `p1.x1`",
+				Computed:            true,
+			},
+			"pods": schema.Int64Attribute{
+				MarkdownDescription: "The recommended number of pods, ie. shards times replicas",
+				Computed:            true,
+			},
+			"replicas": schema.Int64Attribute{
+				MarkdownDescription: "The recommended number of replicas",
+				Computed:            true,
+			},
+			"shards": schema.Int64Attribute{
+				MarkdownDescription: "The recommended number of shards",
+				Computed:            true,
+			},
+			"vectors_per_pod": schema.Int64Attribute{
+				MarkdownDescription: "The estimated number of vectors that fit on a pod of the recommended type",
+				Computed:            true,
+			},
+			"estimated_monthly_cost": schema.Float64Attribute{
+				MarkdownDescription: "The estimated monthly cost of the recommended pods",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Example identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured price table to the datasource
+func (d *CapacityPlanDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(*services.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pinecone.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.prices = client.Prices
+}
+
+func (d *CapacityPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CapacityPlanDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prices := d.prices
+	if prices == nil {
+		prices = services.DefaultPriceTable()
+	}
+
+	plan, err := services.PlanCapacity(services.CapacityRequest{
+		VectorCount:  data.VectorCount.ValueInt64(),
+		Dimension:    data.Dimension.ValueInt64(),
+		MetadataSize: data.MetadataSize.ValueInt64(),
+		QPS:          data.QPS.ValueFloat64(),
+		PodType:      data.PodTypeConstraint.ValueString(),
+	}, prices)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to plan capacity",
+			fmt.Sprintf("Failed to plan capacity: %s", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Planned capacity", map[string]any{"plan": *plan})
+
+	data.Id = types.StringValue(fmt.Sprintf("datasource-pinecone_capacity_plan-%d/%d", data.VectorCount.ValueInt64(), data.Dimension.ValueInt64()))
+	data.PodType = types.StringValue(plan.PodType)
+	data.Pods = types.Int64Value(plan.Pods)
+	data.Replicas = types.Int64Value(plan.Replicas)
+	data.Shards = types.Int64Value(plan.Shards)
+	data.VectorsPerPod = types.Int64Value(plan.VectorsPerPod)
+	data.EstimatedMonthlyCost = types.Float64Value(plan.EstimatedMonthlyCost)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
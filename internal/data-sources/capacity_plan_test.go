@@ -0,0 +1,51 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+func TestCapacityPlanDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	client := services.NewClient("key", "test-env")
+
+	readResp := readDataSource(t, NewCapacityPlanDataSource(), client, map[string]tftypes.Value{
+		"vector_count":        tftypes.NewValue(tftypes.Number, 5000000),
+		"dimension":           tftypes.NewValue(tftypes.Number, 768),
+		"qps":                 tftypes.NewValue(tftypes.Number, 50),
+		"pod_type_constraint": tftypes.NewValue(tftypes.String, "p1"),
+	})
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	want, err := services.PlanCapacity(services.CapacityRequest{VectorCount: 5000000, Dimension: 768, QPS: 50, PodType: "p1"}, client.Prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got CapacityPlanDataSourceModel
+	readResp.State.Get(ctx, &got)
+	if got.PodType.ValueString() != want.PodType || got.Pods.ValueInt64() != want.Pods || got.Replicas.ValueInt64() != want.Replicas || got.Shards.ValueInt64() != want.Shards {
+		t.Errorf("expected %d %s pods as %d shards times %d replicas, got %+v", want.Pods, want.PodType, want.Shards, want.Replicas, got)
+	}
+	if got.VectorsPerPod.ValueInt64() != want.VectorsPerPod || got.EstimatedMonthlyCost.ValueFloat64() != want.EstimatedMonthlyCost {
+		t.Errorf("unexpected vectors per pod %d and cost %v", got.VectorsPerPod.ValueInt64(), got.EstimatedMonthlyCost.ValueFloat64())
+	}
+	if got.Id.ValueString() != "datasource-pinecone_capacity_plan-5000000/768" {
+		t.Errorf("unexpected id %q", got.Id.ValueString())
+	}
+
+	readResp = readDataSource(t, NewCapacityPlanDataSource(), client, map[string]tftypes.Value{
+		"vector_count":        tftypes.NewValue(tftypes.Number, 1000),
+		"dimension":           tftypes.NewValue(tftypes.Number, 768),
+		"pod_type_constraint": tftypes.NewValue(tftypes.String, "p9"),
+	})
+	if !readResp.Diagnostics.HasError() {
+		t.Errorf("expected an error for an unknown pod type constraint")
+	}
+}
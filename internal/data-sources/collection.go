@@ -14,7 +14,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ datasource.DataSource = &CollectionDataSource{}
+	_ datasource.DataSource              = &CollectionDataSource{}
+	_ datasource.DataSourceWithConfigure = &CollectionDataSource{}
 )
 
 func NewCollectionDataSource() datasource.DataSource {
@@ -23,7 +24,7 @@ func NewCollectionDataSource() datasource.DataSource {
 
 // CollectionDataSource defines the data source implementation.
 type CollectionDataSource struct {
-	client services.Pinecone
+	client services.ControlPlane
 }
 
 // CollectionDataSourceModel describes the data source data model.
@@ -71,12 +72,12 @@ func (d *CollectionDataSource) Configure(ctx context.Context, req datasource.Con
 	}
 
 	// extract the client from the provider data
-	client, ok := req.ProviderData.(services.Pinecone)
+	client, ok := req.ProviderData.(services.ControlPlane)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected pinecone.Pinecone, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected pinecone.ControlPlane, got: %T", req.ProviderData),
 		)
 
 		return
@@ -109,7 +110,7 @@ func (d *CollectionDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// log the response
 	tflog.Info(ctx, "DescribeCollection OK", map[string]any{"respond": *response})
 
-	data.Id = types.StringValue(fmt.Sprintf("datasource-pinecone_collection-%s/%s", d.client.Environment, name))
+	data.Id = types.StringValue(fmt.Sprintf("datasource-pinecone_collection-%s/%s", d.client.Environment(), name))
 	data.Name = types.StringValue(response.Name)
 	data.Dimension = types.Int64Value(response.Dimension)
 
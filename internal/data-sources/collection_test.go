@@ -0,0 +1,82 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeControlPlane serves collections and the caller's project from memory.
+type fakeControlPlane struct {
+	services.ControlPlane
+	collections map[string]*services.DescribeCollectionResponse
+	whoami      *services.WhoamiResponse
+}
+
+func (f *fakeControlPlane) Environment() string {
+	return "test-env"
+}
+
+func (f *fakeControlPlane) DescribeCollection(name string) (*services.DescribeCollectionResponse, error) {
+	return f.collections[name], nil
+}
+
+func TestCollectionDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeControlPlane{
+		collections: map[string]*services.DescribeCollectionResponse{
+			"movies": {Name: "movies", Dimension: 1536, Status: "Ready"},
+		},
+	}
+
+	readResp := readDataSource(t, NewCollectionDataSource(), fake, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "movies"),
+	})
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var got CollectionDataSourceModel
+	readResp.State.Get(ctx, &got)
+	if got.Dimension.ValueInt64() != 1536 {
+		t.Errorf("expected dimension 1536, got %d", got.Dimension.ValueInt64())
+	}
+	if got.Id.ValueString() != "datasource-pinecone_collection-test-env/movies" {
+		t.Errorf("unexpected id %q", got.Id.ValueString())
+	}
+}
+
+// readDataSource configures d with providerData and reads it, with the given
+// attributes set in the config and every other attribute null.
+func readDataSource(t *testing.T, d datasource.DataSource, providerData any, overrides map[string]tftypes.Value) *datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	configureResp := &datasource.ConfigureResponse{}
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: providerData}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range overrides {
+		values[name] = value
+	}
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	readResp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, readResp)
+	return readResp
+}
@@ -0,0 +1,158 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &CostEstimateDataSource{}
+	_ datasource.DataSourceWithConfigure = &CostEstimateDataSource{}
+)
+
+func NewCostEstimateDataSource() datasource.DataSource {
+	return &CostEstimateDataSource{}
+}
+
+// CostEstimateDataSource defines the data source implementation.
+type CostEstimateDataSource struct {
+	prices *services.PriceTable
+}
+
+// CostEstimateDataSourceModel describes the data source data model.
+type CostEstimateDataSourceModel struct {
+	PodType              types.String  `tfsdk:"pod_type"`
+	Pods                 types.Int64   `tfsdk:"pods"`
+	HourlyCost           types.Float64 `tfsdk:"hourly_cost"`
+	EstimatedMonthlyCost types.Float64 `tfsdk:"estimated_monthly_cost"`
+	Currency             types.String  `tfsdk:"currency"`
+	PriceTableVersion    types.String  `tfsdk:"price_table_version"`
+	Id                   types.String  `tfsdk:"id"`
+}
+
+func (d *CostEstimateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cost_estimate"
+}
+
+func (d *CostEstimateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Estimates the cost of a pod-based index, without creating it.
+
+Prices come from the price table embedded in the provider, overridden by the provider's ` + "`pod_hourly_prices`" + `. The estimate does not include storage, network or usage based charges.
+- See [Pricing](https://www.pinecone.io/pricing/)
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"pod_type": schema.StringAttribute{
+				MarkdownDescription: "The type of pod, ex. `p1.x1`",
+				Required:            true,
+			},
+			"pods": schema.Int64Attribute{
+				MarkdownDescription: "The number of pods, including replicas. Defaults to `1`.",
+				Optional:            true,
+			},
+			"hourly_cost": schema.Float64Attribute{
+				MarkdownDescription: "The estimated hourly cost of the pods",
+				Computed:            true,
+			},
+			"estimated_monthly_cost": schema.Float64Attribute{
+				MarkdownDescription: "The estimated monthly cost of the pods",
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "The currency of the estimate, ex. `USD`",
+				Computed:            true,
+			},
+			"price_table_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the price table used, ex. `2023-10-01`. Suffixed with `+overrides` when `pod_hourly_prices` is set.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Example identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured price table to the datasource
+func (d *CostEstimateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(*services.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pinecone.Client, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.prices = client.Prices
+}
+
+func (d *CostEstimateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CostEstimateDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prices := d.prices
+	if prices == nil {
+		prices = services.DefaultPriceTable()
+	}
+
+	pods := int64(1)
+	if !data.Pods.IsNull() {
+		pods = data.Pods.ValueInt64()
+	}
+	if pods < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pods"),
+			"Invalid number of pods",
+			fmt.Sprintf("Expected at least 1 pod, got: %d", pods),
+		)
+		return
+	}
+
+	hourly, err := prices.HourlyCost(data.PodType.ValueString(), pods)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pod_type"),
+			"Unknown pod type",
+			fmt.Sprintf("Failed to estimate cost: %s. Set its price with the provider's pod_hourly_prices.", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Estimated cost", map[string]any{"pod_type": data.PodType.ValueString(), "pods": pods, "hourly": hourly})
+
+	data.Id = types.StringValue(fmt.Sprintf("datasource-pinecone_cost_estimate-%s/%d", data.PodType.ValueString(), pods))
+	data.HourlyCost = types.Float64Value(hourly)
+	data.EstimatedMonthlyCost = types.Float64Value(hourly * prices.HoursPerMonth)
+	data.Currency = types.StringValue(prices.Currency)
+	data.PriceTableVersion = types.StringValue(prices.Version)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
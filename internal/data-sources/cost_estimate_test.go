@@ -0,0 +1,45 @@
+package data_sources
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+func TestCostEstimateDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	client := services.NewClient("key", "test-env")
+	client.Prices = client.Prices.WithOverrides(map[string]float64{"p1.x1": 0.1})
+
+	read := func(podType string) (*datasource.ReadResponse, CostEstimateDataSourceModel) {
+		readResp := readDataSource(t, NewCostEstimateDataSource(), client, map[string]tftypes.Value{
+			"pod_type": tftypes.NewValue(tftypes.String, podType),
+			"pods":     tftypes.NewValue(tftypes.Number, 4),
+		})
+
+		var got CostEstimateDataSourceModel
+		readResp.State.Get(ctx, &got)
+		return readResp, got
+	}
+
+	readResp, got := read("p1.x1")
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+	if math.Abs(got.EstimatedMonthlyCost.ValueFloat64()-0.4*730) > 1e-9 {
+		t.Errorf("expected the overridden price for 4 pods, got %v", got.EstimatedMonthlyCost.ValueFloat64())
+	}
+	if got.PriceTableVersion.ValueString() != client.Prices.Version || got.Currency.ValueString() != "USD" {
+		t.Errorf("unexpected price table %q %q", got.PriceTableVersion.ValueString(), got.Currency.ValueString())
+	}
+
+	if readResp, _ := read("p9.x1"); !readResp.Diagnostics.HasError() {
+		t.Errorf("expected an error for an unknown pod type")
+	}
+}
@@ -0,0 +1,186 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &EmbeddingDataSource{}
+	_ datasource.DataSourceWithConfigure = &EmbeddingDataSource{}
+)
+
+func NewEmbeddingDataSource() datasource.DataSource {
+	return &EmbeddingDataSource{}
+}
+
+// EmbeddingDataSource defines the data source implementation.
+type EmbeddingDataSource struct {
+	client services.InferencePlane
+}
+
+// EmbeddingDataSourceModel describes the data source data model.
+type EmbeddingDataSourceModel struct {
+	Model       types.String `tfsdk:"model"`
+	Inputs      types.List   `tfsdk:"inputs"`
+	InputType   types.String `tfsdk:"input_type"`
+	Truncate    types.String `tfsdk:"truncate"`
+	Embeddings  types.List   `tfsdk:"embeddings"`
+	Dimension   types.Int64  `tfsdk:"dimension"`
+	TotalTokens types.Int64  `tfsdk:"total_tokens"`
+	Id          types.String `tfsdk:"id"`
+}
+
+func (d *EmbeddingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embedding"
+}
+
+func (d *EmbeddingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Embeddings of fixed strings, generated with a hosted model, ex. to seed an index or to query it from a check block.
+- See [API Docs](https://docs.pinecone.io/reference/api/2025-04/inference/generate-embeddings)
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The hosted embedding model, ex. `multilingual-e5-large`",
+				Required:            true,
+			},
+			"inputs": schema.ListAttribute{
+				MarkdownDescription: "The strings to embed",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"input_type": schema.StringAttribute{
+				MarkdownDescription: "Whether the inputs are `query` or `passage` strings, for models that embed them differently",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("query", "passage"),
+				},
+			},
+			"truncate": schema.StringAttribute{
+				MarkdownDescription: "`END` truncates inputs longer than the model's maximum sequence length, `NONE` fails instead. Defaults to the model's default.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("END", "NONE"),
+				},
+			},
+			"embeddings": schema.ListAttribute{
+				MarkdownDescription: "The embedding of each input, in the same order as `inputs`",
+				ElementType:         types.ListType{ElemType: types.Float64Type},
+				Computed:            true,
+			},
+			"dimension": schema.Int64Attribute{
+				MarkdownDescription: "The dimension of the embeddings",
+				Computed:            true,
+			},
+			"total_tokens": schema.Int64Attribute{
+				MarkdownDescription: "The number of tokens the inputs were split into",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Example identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the datasource
+func (d *EmbeddingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(services.InferencePlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.InferencePlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EmbeddingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EmbeddingDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var inputs []string
+	resp.Diagnostics.Append(data.Inputs.ElementsAs(ctx, &inputs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := services.EmbedRequest{
+		Model: data.Model.ValueString(),
+		Parameters: services.EmbedParameters{
+			InputType: data.InputType.ValueString(),
+			Truncate:  data.Truncate.ValueString(),
+		},
+	}
+	for _, input := range inputs {
+		request.Inputs = append(request.Inputs, services.EmbedInput{Text: input})
+	}
+
+	embedResponse, err := d.client.Embed(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to embed inputs",
+			fmt.Sprintf("Failed to embed inputs: %s", err),
+		)
+		return
+	}
+
+	// log the response, without the embeddings
+	tflog.Info(ctx, "Embed OK", map[string]any{"model": embedResponse.Model, "count": len(embedResponse.Data), "total_tokens": embedResponse.Usage.TotalTokens})
+
+	if len(embedResponse.Data) != len(inputs) {
+		resp.Diagnostics.AddError(
+			"Failed to embed inputs",
+			fmt.Sprintf("Expected %d embeddings, one per input, got %d.", len(inputs), len(embedResponse.Data)),
+		)
+		return
+	}
+
+	embeddings := [][]float64{}
+	for _, embedding := range embedResponse.Data {
+		embeddings = append(embeddings, embedding.Values)
+	}
+	list, diags := types.ListValueFrom(ctx, types.ListType{ElemType: types.Float64Type}, embeddings)
+	resp.Diagnostics.Append(diags...)
+	data.Embeddings = list
+	data.Dimension = types.Int64Value(int64(len(embeddings[0])))
+	data.TotalTokens = types.Int64Value(embedResponse.Usage.TotalTokens)
+	data.Id = types.StringValue(fmt.Sprintf("datasource-pinecone_embedding-%s", data.Model.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
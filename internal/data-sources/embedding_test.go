@@ -0,0 +1,75 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeInferencePlane embeds every input as a 2 dimensional vector of its
+// length, and lists the given models.
+type fakeInferencePlane struct {
+	requests []services.EmbedRequest
+	models   []services.Model
+	// the type passed to the last ListModels call
+	listedType string
+}
+
+func (f *fakeInferencePlane) Embed(data services.EmbedRequest) (*services.EmbedResponse, error) {
+	f.requests = append(f.requests, data)
+	res := &services.EmbedResponse{Model: data.Model}
+	for _, input := range data.Inputs {
+		res.Data = append(res.Data, services.Embedding{VectorType: "dense", Values: []float64{float64(len(input.Text)), 0.5}})
+	}
+	res.Usage.TotalTokens = 4
+	return res, nil
+}
+
+func (f *fakeInferencePlane) ListModels(modelType string) ([]services.Model, error) {
+	f.listedType = modelType
+	return f.models, nil
+}
+
+func (f *fakeInferencePlane) DescribeModel(name string) (*services.Model, error) {
+	for _, model := range f.models {
+		if model.Name == name {
+			return &model, nil
+		}
+	}
+	return nil, &services.APIError{Operation: "DescribeModel", StatusCode: 404, Message: "not found"}
+}
+
+func TestEmbeddingDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeInferencePlane{}
+
+	readResp := readDataSource(t, NewEmbeddingDataSource(), fake, map[string]tftypes.Value{
+		"model":      tftypes.NewValue(tftypes.String, "multilingual-e5-large"),
+		"input_type": tftypes.NewValue(tftypes.String, "query"),
+		"inputs": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "hello"),
+			tftypes.NewValue(tftypes.String, "hi"),
+		}),
+	})
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	if len(fake.requests) != 1 || fake.requests[0].Parameters.InputType != "query" || fake.requests[0].Parameters.Truncate != "" {
+		t.Errorf("unexpected requests %+v", fake.requests)
+	}
+
+	var state EmbeddingDataSourceModel
+	readResp.State.Get(ctx, &state)
+	var embeddings [][]float64
+	state.Embeddings.ElementsAs(ctx, &embeddings, false)
+	if len(embeddings) != 2 || embeddings[0][0] != 5 || embeddings[1][0] != 2 {
+		t.Errorf("expected one embedding per input in order, got %v", embeddings)
+	}
+	if state.Dimension.ValueInt64() != 2 || state.TotalTokens.ValueInt64() != 4 {
+		t.Errorf("unexpected dimension %d and total tokens %d", state.Dimension.ValueInt64(), state.TotalTokens.ValueInt64())
+	}
+}
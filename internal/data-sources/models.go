@@ -0,0 +1,239 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ModelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ModelsDataSource{}
+)
+
+func NewModelsDataSource() datasource.DataSource {
+	return &ModelsDataSource{}
+}
+
+// ModelsDataSource defines the data source implementation.
+type ModelsDataSource struct {
+	client services.InferencePlane
+}
+
+// ModelsDataSourceModel describes the data source data model.
+type ModelsDataSourceModel struct {
+	Name   types.String `tfsdk:"name"`
+	Type   types.String `tfsdk:"type"`
+	Models []modelModel `tfsdk:"models"`
+	Id     types.String `tfsdk:"id"`
+}
+
+type modelModel struct {
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	VectorType          types.String `tfsdk:"vector_type"`
+	DefaultDimension    types.Int64  `tfsdk:"default_dimension"`
+	SupportedDimensions types.List   `tfsdk:"supported_dimensions"`
+	SupportedMetrics    types.List   `tfsdk:"supported_metrics"`
+	MaxSequenceLength   types.Int64  `tfsdk:"max_sequence_length"`
+	MaxBatchSize        types.Int64  `tfsdk:"max_batch_size"`
+	ShortDescription    types.String `tfsdk:"short_description"`
+}
+
+func (d *ModelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_models"
+}
+
+func (d *ModelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `The hosted inference models, ex. to take the dimension of an index from its embedding model.
+- See [API Docs](https://docs.pinecone.io/reference/api/2025-04/inference/list_models)
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The model to describe, ex. `multilingual-e5-large`. When set, `models` only holds this model.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list models of this type, `embed` or `rerank`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("embed", "rerank"),
+				},
+			},
+			"models": schema.ListNestedAttribute{
+				MarkdownDescription: "The models",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the model",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the model, `embed` or `rerank`",
+							Computed:            true,
+						},
+						"vector_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the embeddings, `dense` or `sparse`. Null for rerank models.",
+							Computed:            true,
+						},
+						"default_dimension": schema.Int64Attribute{
+							MarkdownDescription: "The dimension of the embeddings. Null for sparse and rerank models.",
+							Computed:            true,
+						},
+						"supported_dimensions": schema.ListAttribute{
+							MarkdownDescription: "The dimensions the model can embed into",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+						"supported_metrics": schema.ListAttribute{
+							MarkdownDescription: "The distance metrics an index using the model can use",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"max_sequence_length": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of tokens of an input",
+							Computed:            true,
+						},
+						"max_batch_size": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of inputs per request",
+							Computed:            true,
+						},
+						"short_description": schema.StringAttribute{
+							MarkdownDescription: "A description of the model",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Example identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the datasource
+func (d *ModelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(services.InferencePlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.InferencePlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var models []services.Model
+	if !data.Name.IsNull() {
+		model, err := d.client.DescribeModel(data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to describe model",
+				fmt.Sprintf("Failed to describe model: %s", err),
+			)
+			return
+		}
+		// log the response
+		tflog.Info(ctx, "DescribeModel OK", map[string]any{"response": *model})
+
+		if data.Type.IsNull() || model.Type == data.Type.ValueString() {
+			models = append(models, *model)
+		}
+	} else {
+		var err error
+		models, err = d.client.ListModels(data.Type.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to list models",
+				fmt.Sprintf("Failed to list models: %s", err),
+			)
+			return
+		}
+
+		// log the response
+		tflog.Info(ctx, "ListModels OK", map[string]any{"count": len(models)})
+	}
+
+	data.Models = []modelModel{}
+	for _, model := range models {
+		data.Models = append(data.Models, toModelModel(ctx, model, &resp.Diagnostics))
+	}
+	data.Id = types.StringValue(fmt.Sprintf("datasource-pinecone_models-%s-%s", data.Type.ValueString(), data.Name.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// toModelModel converts a model, with nulls for the values that do not apply
+// to its type.
+func toModelModel(ctx context.Context, model services.Model, diags *diag.Diagnostics) modelModel {
+	m := modelModel{
+		Name:              types.StringValue(model.Name),
+		Type:              types.StringValue(model.Type),
+		VectorType:        types.StringNull(),
+		DefaultDimension:  types.Int64Null(),
+		MaxSequenceLength: types.Int64Value(model.MaxSequenceLength),
+		MaxBatchSize:      types.Int64Value(model.MaxBatchSize),
+		ShortDescription:  types.StringValue(model.ShortDescription),
+	}
+	if model.VectorType != "" {
+		m.VectorType = types.StringValue(model.VectorType)
+	}
+	if model.DefaultDimension != 0 {
+		m.DefaultDimension = types.Int64Value(model.DefaultDimension)
+	}
+
+	supportedDimensions := model.SupportedDimensions
+	if supportedDimensions == nil {
+		supportedDimensions = []int64{}
+	}
+	dimensions, d := types.ListValueFrom(ctx, types.Int64Type, supportedDimensions)
+	diags.Append(d...)
+	m.SupportedDimensions = dimensions
+
+	supportedMetrics := model.SupportedMetrics
+	if supportedMetrics == nil {
+		supportedMetrics = []string{}
+	}
+	metrics, d := types.ListValueFrom(ctx, types.StringType, supportedMetrics)
+	diags.Append(d...)
+	m.SupportedMetrics = metrics
+
+	return m
+}
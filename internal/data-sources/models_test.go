@@ -0,0 +1,48 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+func TestModelsDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeInferencePlane{models: []services.Model{
+		{Name: "multilingual-e5-large", Type: "embed", VectorType: "dense", DefaultDimension: 1024, SupportedDimensions: []int64{1024}, SupportedMetrics: []string{"cosine", "euclidean"}, MaxSequenceLength: 507, MaxBatchSize: 96},
+		{Name: "bge-reranker-v2-m3", Type: "rerank", MaxSequenceLength: 1024, MaxBatchSize: 100},
+	}}
+
+	read := func(attributes map[string]tftypes.Value) ModelsDataSourceModel {
+		t.Helper()
+		readResp := readDataSource(t, NewModelsDataSource(), fake, attributes)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+		}
+
+		var state ModelsDataSourceModel
+		readResp.State.Get(ctx, &state)
+		return state
+	}
+
+	all := read(map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "embed")})
+	if fake.listedType != "embed" || len(all.Models) != 2 {
+		t.Errorf("expected the models of the type to be listed, got %q %d", fake.listedType, len(all.Models))
+	}
+	if !all.Models[1].DefaultDimension.IsNull() || !all.Models[1].VectorType.IsNull() {
+		t.Errorf("expected a null dimension and vector type for a rerank model, got %+v", all.Models[1])
+	}
+
+	e5 := read(map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "multilingual-e5-large")})
+	if len(e5.Models) != 1 || e5.Models[0].DefaultDimension.ValueInt64() != 1024 || e5.Models[0].MaxSequenceLength.ValueInt64() != 507 {
+		t.Fatalf("unexpected models %+v", e5.Models)
+	}
+	var metrics []string
+	e5.Models[0].SupportedMetrics.ElementsAs(ctx, &metrics, false)
+	if len(metrics) != 2 || metrics[1] != "euclidean" {
+		t.Errorf("unexpected supported metrics %v", metrics)
+	}
+}
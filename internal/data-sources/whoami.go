@@ -0,0 +1,129 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &WhoamiDataSource{}
+	_ datasource.DataSourceWithConfigure = &WhoamiDataSource{}
+)
+
+func NewWhoamiDataSource() datasource.DataSource {
+	return &WhoamiDataSource{}
+}
+
+// WhoamiDataSource defines the data source implementation.
+type WhoamiDataSource struct {
+	client services.ControlPlane
+}
+
+// WhoamiDataSourceModel describes the data source data model.
+type WhoamiDataSourceModel struct {
+	ProjectName types.String `tfsdk:"project_name"`
+	UserLabel   types.String `tfsdk:"user_label"`
+	UserName    types.String `tfsdk:"user_name"`
+	Environment types.String `tfsdk:"environment"`
+	Id          types.String `tfsdk:"id"`
+}
+
+func (d *WhoamiDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_whoami"
+}
+
+func (d *WhoamiDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `The project that the configured API key belongs to.
+
+Use it in a postcondition to assert that the provider is pointed at the intended project before creating anything.
+- See [API Docs](https://docs.pinecone.io/reference/whoami)
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"project_name": schema.StringAttribute{
+				MarkdownDescription: "The project the API key belongs to. This is the project id that appears in index hosts.",
+				Computed:            true,
+			},
+			"user_label": schema.StringAttribute{
+				MarkdownDescription: "The label of the API key, ex. `default`",
+				Computed:            true,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The user the API key was issued to",
+				Computed:            true,
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "The environment the provider is configured for, ex. `us-west4-gcp-free`",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Example identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the datasource
+func (d *WhoamiDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(services.ControlPlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.ControlPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WhoamiDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WhoamiDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Whoami()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to look up project",
+			fmt.Sprintf("Failed to look up project: %s", err),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "Whoami OK", map[string]any{"response": *response})
+
+	data.Id = types.StringValue(fmt.Sprintf("datasource-pinecone_whoami-%s/%s", d.client.Environment(), response.ProjectName))
+	data.ProjectName = types.StringValue(response.ProjectName)
+	data.UserLabel = types.StringValue(response.UserLabel)
+	data.UserName = types.StringValue(response.UserName)
+	data.Environment = types.StringValue(d.client.Environment())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
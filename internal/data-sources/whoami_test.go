@@ -0,0 +1,31 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+func (f *fakeControlPlane) Whoami() (*services.WhoamiResponse, error) {
+	return f.whoami, nil
+}
+
+func TestWhoamiDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeControlPlane{whoami: &services.WhoamiResponse{ProjectName: "abc1234", UserLabel: "default", UserName: "user"}}
+
+	readResp := readDataSource(t, NewWhoamiDataSource(), fake, nil)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var got WhoamiDataSourceModel
+	readResp.State.Get(ctx, &got)
+	if got.ProjectName.ValueString() != "abc1234" || got.UserLabel.ValueString() != "default" || got.UserName.ValueString() != "user" {
+		t.Errorf("unexpected whoami %+v", got)
+	}
+	if got.Environment.ValueString() != "test-env" || got.Id.ValueString() != "datasource-pinecone_whoami-test-env/abc1234" {
+		t.Errorf("unexpected environment %q and id %q", got.Environment.ValueString(), got.Id.ValueString())
+	}
+}
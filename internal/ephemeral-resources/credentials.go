@@ -0,0 +1,139 @@
+package ephemeral_resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &CredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &CredentialsEphemeralResource{}
+)
+
+// credentialsClient is the part of the provider configured client the
+// ephemeral resource needs: the controller to look up index hosts and the
+// key the client authenticates with.
+type credentialsClient interface {
+	services.ControlPlane
+	ApiKey() string
+}
+
+func NewCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &CredentialsEphemeralResource{}
+}
+
+// CredentialsEphemeralResource defines the ephemeral resource implementation.
+type CredentialsEphemeralResource struct {
+	client credentialsClient
+}
+
+// CredentialsEphemeralResourceModel describes the ephemeral resource data model.
+type CredentialsEphemeralResourceModel struct {
+	IndexName   types.String `tfsdk:"index_name"`
+	ApiKey      types.String `tfsdk:"api_key"`
+	Environment types.String `tfsdk:"environment"`
+	Host        types.String `tfsdk:"host"`
+}
+
+func (e *CredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credentials"
+}
+
+func (e *CredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `The API key the provider is configured with and, optionally, the host of an index.
+
+The values are never written to the plan or state. Pass them to write-only arguments of other providers, ex. a secret store, to hand them to applications.
+Requires Terraform 1.10 or later.
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"index_name": schema.StringAttribute{
+				MarkdownDescription: "The index to look up the host of. When omitted, `host` is null.",
+				Optional:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "The API key the provider is configured with. Null when the provider authenticates with a service account only, ex. when it is scoped to a project.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "The environment the provider is configured for, ex. `us-west4-gcp-free`",
+				Computed:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The data plane host of `index_name`, ex. `example-index-1234567.svc.us-west4-gcp-free.pinecone.io`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource
+func (e *CredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(credentialsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected a pinecone.ControlPlane with an ApiKey method, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *CredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// the result holds the api key, so only the index name is logged
+	var data CredentialsEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "CredentialsEphemeralResource.Open", map[string]any{"index_name": data.IndexName.ValueString()})
+
+	// a provider scoped to a project authenticates with its service account instead
+	data.ApiKey = types.StringNull()
+	if apiKey := e.client.ApiKey(); apiKey != "" {
+		data.ApiKey = types.StringValue(apiKey)
+	}
+	data.Environment = types.StringValue(e.client.Environment())
+	data.Host = types.StringNull()
+
+	if !data.IndexName.IsNull() {
+		index, err := e.client.DescribeIndex(data.IndexName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to describe index",
+				fmt.Sprintf("Failed to describe index: %s", err),
+			)
+			return
+		}
+
+		data.Host = types.StringValue(index.Status.Host)
+	}
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,95 @@
+package ephemeral_resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeClient reports the provider's API key and the hosts of its indexes.
+type fakeClient struct {
+	services.ControlPlane
+	hosts map[string]string
+}
+
+func (f *fakeClient) Environment() string {
+	return "test-env"
+}
+
+func (f *fakeClient) ApiKey() string {
+	return "secret-key"
+}
+
+func (f *fakeClient) DescribeIndex(name string) (*services.DescribeIndexResponse, error) {
+	host, ok := f.hosts[name]
+	if !ok {
+		return nil, &services.APIError{Operation: "describe_index", StatusCode: 404, Message: "not found"}
+	}
+	index := &services.DescribeIndexResponse{}
+	index.Status.Host = host
+	return index, nil
+}
+
+func TestCredentialsEphemeralResourceOpen(t *testing.T) {
+	ctx := context.Background()
+
+	e := NewCredentialsEphemeralResource()
+	configureResp := &ephemeral.ConfigureResponse{}
+	e.(ephemeral.EphemeralResourceWithConfigure).Configure(ctx, ephemeral.ConfigureRequest{
+		ProviderData: &fakeClient{hosts: map[string]string{"movies": "movies-abc.svc.test-env.pinecone.io"}},
+	}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	e.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+	open := func(indexName *string) (*ephemeral.OpenResponse, CredentialsEphemeralResourceModel) {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, typ := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		if indexName != nil {
+			values["index_name"] = tftypes.NewValue(tftypes.String, *indexName)
+		}
+
+		openResp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema}}
+		e.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, openResp)
+
+		var got CredentialsEphemeralResourceModel
+		openResp.Result.Get(ctx, &got)
+		return openResp, got
+	}
+
+	openResp, got := open(nil)
+	if openResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected open diagnostics: %v", openResp.Diagnostics)
+	}
+	if got.ApiKey.ValueString() != "secret-key" || got.Environment.ValueString() != "test-env" {
+		t.Errorf("unexpected credentials %q %q", got.ApiKey.ValueString(), got.Environment.ValueString())
+	}
+	if !got.Host.IsNull() {
+		t.Errorf("expected a null host without an index name, got %q", got.Host.ValueString())
+	}
+
+	name := "movies"
+	openResp, got = open(&name)
+	if openResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected open diagnostics: %v", openResp.Diagnostics)
+	}
+	if got.Host.ValueString() != "movies-abc.svc.test-env.pinecone.io" {
+		t.Errorf("unexpected host %q", got.Host.ValueString())
+	}
+
+	missing := "missing"
+	if openResp, _ := open(&missing); !openResp.Diagnostics.HasError() {
+		t.Errorf("expected an error for a missing index")
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+var errProfileNotFound = errors.New("profile not found")
+
+// profileCredentials is a single profile of the shared credentials file.
+//
+//	[default]
+//	api_key     = ...
+//	environment = us-west4-gcp-free
+//
+//	[staging]
+//	api_key     = ...
+//	environment = us-east1-gcp
+type profileCredentials struct {
+	ApiKey      string
+	Environment string
+}
+
+// defaultSharedCredentialsFile returns ~/.pinecone/credentials, or "" if the
+// home directory cannot be determined.
+func defaultSharedCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".pinecone", "credentials")
+}
+
+// parseSharedCredentials reads an ini style credentials file into profiles
+// keyed by name. Blank lines and lines starting with # or ; are ignored.
+func parseSharedCredentials(r io.Reader) (map[string]profileCredentials, error) {
+	profiles := map[string]profileCredentials{}
+	profile := ""
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			profile = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := profiles[profile]; !ok {
+				profiles[profile] = profileCredentials{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected a [profile] header or a key = value pair", lineNumber)
+		}
+		if profile == "" {
+			return nil, fmt.Errorf("line %d: %q is not inside a [profile] section", lineNumber, strings.TrimSpace(key))
+		}
+
+		credentials := profiles[profile]
+		switch strings.TrimSpace(key) {
+		case "api_key":
+			credentials.ApiKey = strings.TrimSpace(value)
+		case "environment":
+			credentials.Environment = strings.TrimSpace(value)
+		}
+		profiles[profile] = credentials
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// loadProfile reads a single profile from the shared credentials file.
+func loadProfile(path string, profile string) (*profileCredentials, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := parseSharedCredentials(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	credentials, ok := profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q in %s", errProfileNotFound, profile, path)
+	}
+	return &credentials, nil
+}
+
+// readApiKeyFile returns the contents of path, without surrounding whitespace.
+func readApiKeyFile(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// runApiKeyCommand runs a credential helper, ex. a vault CLI, and returns its
+// standard output without surrounding whitespace. The command is executed
+// directly, without a shell.
+func runApiKeyCommand(ctx context.Context, args []string) (string, error) {
+	if len(args) == 0 {
+		return "", fmt.Errorf("no command specified")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// credentialsDiagnostic explains why validating the credentials failed,
+// pointing at the attribute most likely to be wrong.
+func credentialsDiagnostic(environment string, err error) diag.Diagnostic {
+	var apiErr *services.APIError
+	var dnsErr *net.DNSError
+
+	switch {
+	case errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden):
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root("apikey"),
+			"Invalid Pinecone API Key",
+			fmt.Sprintf("The API key was rejected by the %q environment (status code %d). "+
+				"Check that the key has not been revoked, and that it belongs to a project in this environment.", environment, apiErr.StatusCode),
+		)
+	case errors.As(err, &dnsErr), errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root("environment"),
+			"Unknown Pinecone Environment",
+			fmt.Sprintf("The controller for the %q environment could not be reached. "+
+				"Check the environment shown next to the API key in the Pinecone console, ex. us-west4-gcp-free: %s", environment, err),
+		)
+	default:
+		return diag.NewErrorDiagnostic(
+			"Unable to Validate Pinecone Credentials",
+			fmt.Sprintf("Looking up the project of the API key failed: %s", err),
+		)
+	}
+}
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+const testCredentials = `
+# shared by every workspace
+[default]
+api_key     = default-key
+environment = us-west4-gcp-free
+
+; staging project
+[staging]
+api_key = staging-key
+environment=us-east1-gcp
+`
+
+func TestParseSharedCredentials(t *testing.T) {
+	profiles, err := parseSharedCredentials(strings.NewReader(testCredentials))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := profiles["default"]; got.ApiKey != "default-key" || got.Environment != "us-west4-gcp-free" {
+		t.Errorf("unexpected default profile %+v", got)
+	}
+	if got := profiles["staging"]; got.ApiKey != "staging-key" || got.Environment != "us-east1-gcp" {
+		t.Errorf("unexpected staging profile %+v", got)
+	}
+
+	if _, err := parseSharedCredentials(strings.NewReader("api_key = orphan")); err == nil {
+		t.Error("expected an error for a key outside of a profile")
+	}
+}
+
+func TestLoadProfileNotFound(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials")
+	os.WriteFile(file, []byte(testCredentials), 0600)
+
+	if _, err := loadProfile(file, "production"); !errors.Is(err, errProfileNotFound) {
+		t.Errorf("expected errProfileNotFound, got %v", err)
+	}
+}
+
+func TestRunApiKeyCommand(t *testing.T) {
+	key, err := runApiKeyCommand(context.Background(), []string{"sh", "-c", "echo ' command-key '"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "command-key" {
+		t.Errorf("expected command-key, got %q", key)
+	}
+
+	if _, err := runApiKeyCommand(context.Background(), []string{"sh", "-c", "echo denied >&2; exit 1"}); err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("expected the command's stderr in the error, got %v", err)
+	}
+}
+
+// configure runs the provider's Configure with the given attributes set.
+func configure(t *testing.T, attributes map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	p := New("test")()
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	return resp
+}
+
+func TestConfigureCredentialSources(t *testing.T) {
+	t.Setenv("PINECONE_API_KEY", "env-key")
+	t.Setenv("PINECONE_ENVIRONMENT", "env-environment")
+	t.Setenv("PINECONE_PROFILE", "")
+
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	os.WriteFile(credentialsFile, []byte(testCredentials), 0600)
+	t.Setenv("PINECONE_SHARED_CREDENTIALS_FILE", credentialsFile)
+
+	keyFile := filepath.Join(t.TempDir(), "key")
+	os.WriteFile(keyFile, []byte("file-key\n"), 0600)
+
+	tests := []struct {
+		name            string
+		attributes      map[string]tftypes.Value
+		wantApiKey      string
+		wantEnvironment string
+	}{
+		{
+			name:            "environment variables",
+			wantApiKey:      "env-key",
+			wantEnvironment: "env-environment",
+		},
+		{
+			name: "named profile overrides environment variables",
+			attributes: map[string]tftypes.Value{
+				"profile": tftypes.NewValue(tftypes.String, "staging"),
+			},
+			wantApiKey:      "staging-key",
+			wantEnvironment: "us-east1-gcp",
+		},
+		{
+			name: "api key file overrides profile",
+			attributes: map[string]tftypes.Value{
+				"profile":      tftypes.NewValue(tftypes.String, "staging"),
+				"api_key_file": tftypes.NewValue(tftypes.String, keyFile),
+			},
+			wantApiKey:      "file-key",
+			wantEnvironment: "us-east1-gcp",
+		},
+		{
+			name: "api key command",
+			attributes: map[string]tftypes.Value{
+				"api_key_command": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "echo"),
+					tftypes.NewValue(tftypes.String, "command-key"),
+				}),
+			},
+			wantApiKey:      "command-key",
+			wantEnvironment: "env-environment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := configure(t, tt.attributes)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			client := resp.ResourceData.(*services.Client)
+			if client.Environment() != tt.wantEnvironment {
+				t.Errorf("expected environment %q, got %q", tt.wantEnvironment, client.Environment())
+			}
+			if client.ApiKey() != tt.wantApiKey {
+				t.Errorf("expected api key %q, got %q", tt.wantApiKey, client.ApiKey())
+			}
+		})
+	}
+}
+
+func TestConfigureDefaultProfileFallback(t *testing.T) {
+	t.Setenv("PINECONE_API_KEY", "")
+	t.Setenv("PINECONE_ENVIRONMENT", "")
+	t.Setenv("PINECONE_PROFILE", "")
+
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	os.WriteFile(credentialsFile, []byte(testCredentials), 0600)
+	t.Setenv("PINECONE_SHARED_CREDENTIALS_FILE", credentialsFile)
+
+	resp := configure(t, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	client := resp.ResourceData.(*services.Client)
+	if client.ApiKey() != "default-key" || client.Environment() != "us-west4-gcp-free" {
+		t.Errorf("expected the default profile, got %q in %q", client.ApiKey(), client.Environment())
+	}
+}
+
+func TestConfigureConflictingApiKeys(t *testing.T) {
+	resp := configure(t, map[string]tftypes.Value{
+		"apikey":       tftypes.NewValue(tftypes.String, "a"),
+		"api_key_file": tftypes.NewValue(tftypes.String, "/dev/null"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when multiple API key sources are set")
+	}
+}
+
+func TestConfigureMissingCredentials(t *testing.T) {
+	t.Setenv("PINECONE_API_KEY", "")
+	t.Setenv("PINECONE_ENVIRONMENT", "")
+	t.Setenv("PINECONE_PROFILE", "")
+	t.Setenv("PINECONE_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+
+	resp := configure(t, nil)
+
+	summaries := map[string]bool{}
+	for _, d := range resp.Diagnostics.Errors() {
+		summaries[d.Summary()] = true
+		if !strings.Contains(d.Detail(), "PINECONE_") {
+			t.Errorf("expected the detail to name the environment variable, got %q", d.Detail())
+		}
+	}
+	if !summaries["Missing Pinecone API Key"] || !summaries["Missing Pinecone Environment"] {
+		t.Errorf("unexpected diagnostics %v", resp.Diagnostics)
+	}
+}
+
+func TestCredentialsDiagnostic(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantSummary string
+	}{
+		{"invalid key", &services.APIError{Operation: "Whoami", StatusCode: 401}, "Invalid Pinecone API Key"},
+		{"forbidden", &services.APIError{Operation: "Whoami", StatusCode: 403}, "Invalid Pinecone API Key"},
+		{"unknown environment", &net.DNSError{Err: "no such host", Name: "controller.typo.pinecone.io", IsNotFound: true}, "Unknown Pinecone Environment"},
+		{"server error", &services.APIError{Operation: "Whoami", StatusCode: 500}, "Unable to Validate Pinecone Credentials"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := credentialsDiagnostic("typo", tt.err)
+			if got.Summary() != tt.wantSummary {
+				t.Errorf("expected %q, got %q", tt.wantSummary, got.Summary())
+			}
+		})
+	}
+}
+
+func TestConfigureDefaultTags(t *testing.T) {
+	t.Setenv("PINECONE_API_KEY", "env-key")
+	t.Setenv("PINECONE_ENVIRONMENT", "env-environment")
+	t.Setenv("PINECONE_PROFILE", "")
+	t.Setenv("PINECONE_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+
+	resp := configure(t, map[string]tftypes.Value{
+		"default_tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"team": tftypes.NewValue(tftypes.String, "search"),
+		}),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	client := resp.ResourceData.(*services.Client)
+	if client.DefaultTags["team"] != "search" {
+		t.Errorf("expected the default tags on the client, got %v", client.DefaultTags)
+	}
+}
+
+func TestConfigureServiceAccount(t *testing.T) {
+	t.Setenv("PINECONE_API_KEY", "env-key")
+	t.Setenv("PINECONE_ENVIRONMENT", "env-environment")
+	t.Setenv("PINECONE_PROFILE", "")
+	t.Setenv("PINECONE_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("PINECONE_CLIENT_ID", "env-client")
+	t.Setenv("PINECONE_CLIENT_SECRET", "")
+
+	if resp := configure(t, nil); !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when only the client id is set")
+	}
+
+	resp := configure(t, map[string]tftypes.Value{
+		"client_secret": tftypes.NewValue(tftypes.String, "secret"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	client := resp.ResourceData.(*services.Client)
+	if client.ServiceAccount == nil || client.ServiceAccount.ClientId != "env-client" || client.ServiceAccount.ClientSecret != "secret" {
+		t.Errorf("expected the service account on the client, got %+v", client.ServiceAccount)
+	}
+}
+
+func TestConfigureProjectScope(t *testing.T) {
+	t.Setenv("PINECONE_API_KEY", "")
+	t.Setenv("PINECONE_ENVIRONMENT", "env-environment")
+	t.Setenv("PINECONE_PROFILE", "")
+	t.Setenv("PINECONE_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("PINECONE_CLIENT_ID", "")
+	t.Setenv("PINECONE_CLIENT_SECRET", "")
+	t.Setenv("PINECONE_PROJECT_ID", "env-project")
+
+	if resp := configure(t, nil); !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when a project is set without a service account")
+	}
+
+	// the service account replaces the API key
+	resp := configure(t, map[string]tftypes.Value{
+		"client_id":     tftypes.NewValue(tftypes.String, "client"),
+		"client_secret": tftypes.NewValue(tftypes.String, "secret"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	client := resp.ResourceData.(*services.Client)
+	if client.ProjectId != "env-project" {
+		t.Errorf("expected the client to be scoped to the project, got %q", client.ProjectId)
+	}
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	resources "github.com/thiskevinwang/terraform-provider-pinecone/internal/resources"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ function.Function = &podTypeFunction{}
+	_ function.Function = &parseIndexIdFunction{}
+	_ function.Function = &indexHostUrlFunction{}
+	_ function.Function = &metadataConfigFunction{}
+)
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// codeList formats values as a markdown list, ex. `a`, `b` or `c`.
+func codeList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "`" + v + "`"
+	}
+	if len(quoted) < 2 {
+		return strings.Join(quoted, "")
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
+
+// pod_type("p1", "x2") = "p1.x2", pod_type("starter", "") = "starter"
+type podTypeFunction struct{}
+
+func NewPodTypeFunction() function.Function {
+	return &podTypeFunction{}
+}
+
+func (f *podTypeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pod_type"
+}
+
+func (f *podTypeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a pod type",
+		MarkdownDescription: "Builds the `pod_type` of an index from a pod family and size, ex. `provider::pinecone::pod_type(\"p1\", \"x2\")` returns `p1.x2`. The starter pods of the free environment have no size, `provider::pinecone::pod_type(\"starter\", \"\")` returns `starter`. Fails if the family or size is not valid.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "family",
+				MarkdownDescription: fmt.Sprintf("The pod family, one of %s", codeList(append(resources.PodFamilies(), resources.StarterPodType))),
+			},
+			function.StringParameter{
+				Name:                "size",
+				MarkdownDescription: fmt.Sprintf("The pod size, one of %s, or empty for `%s`", codeList(resources.PodSizes()), resources.StarterPodType),
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *podTypeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var family, size string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &family, &size))
+	if resp.Error != nil {
+		return
+	}
+
+	if family == resources.StarterPodType {
+		if size != "" {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid pod size %q, %s pods have no size", size, resources.StarterPodType))
+			return
+		}
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, family))
+		return
+	}
+
+	if !contains(resources.PodFamilies(), family) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Invalid pod family %q, expected one of %s or %s", family, strings.Join(resources.PodFamilies(), ", "), resources.StarterPodType)))
+	}
+	if !contains(resources.PodSizes(), size) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("Invalid pod size %q, expected one of %s", size, strings.Join(resources.PodSizes(), ", "))))
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, family+"."+size))
+}
+
+// parse_index_id("us-west4-gcp-free/my-index") = { environment = "us-west4-gcp-free", name = "my-index" }
+type parseIndexIdFunction struct{}
+
+func NewParseIndexIdFunction() function.Function {
+	return &parseIndexIdFunction{}
+}
+
+var indexIdAttributeTypes = map[string]attr.Type{
+	"environment": types.StringType,
+	"name":        types.StringType,
+}
+
+func (f *parseIndexIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_index_id"
+}
+
+func (f *parseIndexIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses the id of an index",
+		MarkdownDescription: "Splits the `id` of a `pinecone_index`, ex. `us-west4-gcp-free/my-index`, into an object with its `environment` and `name`. Indexes get this id whether they are created or imported.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The id of an index, `{environment}/{name}`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: indexIdAttributeTypes,
+		},
+	}
+}
+
+func (f *parseIndexIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	environment, name, ok := strings.Cut(id, "/")
+	if !ok || environment == "" || name == "" || strings.Contains(name, "/") {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid index id %q, expected {environment}/{name}", id))
+		return
+	}
+
+	result, diags := types.ObjectValue(indexIdAttributeTypes, map[string]attr.Value{
+		"environment": types.StringValue(environment),
+		"name":        types.StringValue(name),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// index_host_url("my-index", "abc1234", "us-west4-gcp-free") = "https://my-index-abc1234.svc.us-west4-gcp-free.pinecone.io"
+type indexHostUrlFunction struct{}
+
+func NewIndexHostUrlFunction() function.Function {
+	return &indexHostUrlFunction{}
+}
+
+func (f *indexHostUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "index_host_url"
+}
+
+func (f *indexHostUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds the data plane URL of an index",
+		MarkdownDescription: "Builds the data plane URL of an index, ex. `https://my-index-abc1234.svc.us-west4-gcp-free.pinecone.io`, without looking the index up. The project name is the `project_name` of the `pinecone_whoami` data source. Prefer the `host` of a `pinecone_index` managed in the same configuration.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name of the index",
+			},
+			function.StringParameter{
+				Name:                "project_name",
+				MarkdownDescription: "The project of the index",
+			},
+			function.StringParameter{
+				Name:                "environment",
+				MarkdownDescription: "The environment of the index, ex. `us-west4-gcp-free`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *indexHostUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, projectName, environment string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &projectName, &environment))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, value := range []string{name, projectName, environment} {
+		if value == "" {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), "Must not be empty"))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fmt.Sprintf("https://%s-%s.svc.%s.pinecone.io", name, projectName, environment)))
+}
+
+// metadata_config(["genre", "year", "genre"]) = { indexed = ["genre", "year"] }
+type metadataConfigFunction struct{}
+
+func NewMetadataConfigFunction() function.Function {
+	return &metadataConfigFunction{}
+}
+
+var metadataConfigAttributeTypes = map[string]attr.Type{
+	"indexed": types.ListType{ElemType: types.StringType},
+}
+
+func (f *metadataConfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "metadata_config"
+}
+
+func (f *metadataConfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a metadata_config",
+		MarkdownDescription: "Builds the `metadata_config` of an index from the metadata fields to index. The fields are sorted and duplicates removed, so that combining lists from several modules does not cause a diff, which would replace the index.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "fields",
+				MarkdownDescription: "The metadata fields to index",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: metadataConfigAttributeTypes,
+		},
+	}
+}
+
+func (f *metadataConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var fields []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &fields))
+	if resp.Error != nil {
+		return
+	}
+
+	unique := map[string]bool{}
+	indexed := []attr.Value{}
+	sort.Strings(fields)
+	for _, field := range fields {
+		if field == "" {
+			resp.Error = function.NewArgumentFuncError(0, "Metadata field names must not be empty")
+			return
+		}
+		if !unique[field] {
+			unique[field] = true
+			indexed = append(indexed, types.StringValue(field))
+		}
+	}
+
+	result, diags := types.ObjectValue(metadataConfigAttributeTypes, map[string]attr.Value{
+		"indexed": types.ListValueMust(types.StringType, indexed),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls f with the arguments, returning its result or error.
+func runFunction(f function.Function, result attr.Value, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestPodTypeFunction(t *testing.T) {
+	got, err := runFunction(NewPodTypeFunction(), types.StringUnknown(), types.StringValue("p1"), types.StringValue("x2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(types.StringValue("p1.x2")) {
+		t.Errorf("expected p1.x2, got %s", got)
+	}
+
+	_, err = runFunction(NewPodTypeFunction(), types.StringUnknown(), types.StringValue("p3"), types.StringValue("x3"))
+	if err == nil || err.FunctionArgument == nil || *err.FunctionArgument != 0 {
+		t.Errorf("expected an error for the first argument, got %v", err)
+	}
+
+	got, err = runFunction(NewPodTypeFunction(), types.StringUnknown(), types.StringValue("starter"), types.StringValue(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(types.StringValue("starter")) {
+		t.Errorf("expected starter, got %s", got)
+	}
+
+	_, err = runFunction(NewPodTypeFunction(), types.StringUnknown(), types.StringValue("starter"), types.StringValue("x1"))
+	if err == nil || err.FunctionArgument == nil || *err.FunctionArgument != 1 {
+		t.Errorf("expected an error for the size of starter pods, got %v", err)
+	}
+}
+
+func TestParseIndexIdFunction(t *testing.T) {
+	got, err := runFunction(NewParseIndexIdFunction(), types.ObjectUnknown(indexIdAttributeTypes), types.StringValue("us-west4-gcp-free/my-index"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := types.ObjectValueMust(indexIdAttributeTypes, map[string]attr.Value{
+		"environment": types.StringValue("us-west4-gcp-free"),
+		"name":        types.StringValue("my-index"),
+	})
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+
+	for _, id := range []string{"my-index", "/my-index", "env/", "env/a/b"} {
+		if _, err := runFunction(NewParseIndexIdFunction(), types.ObjectUnknown(indexIdAttributeTypes), types.StringValue(id)); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestIndexHostUrlFunction(t *testing.T) {
+	got, err := runFunction(NewIndexHostUrlFunction(), types.StringUnknown(), types.StringValue("my-index"), types.StringValue("abc1234"), types.StringValue("us-west4-gcp-free"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(types.StringValue("https://my-index-abc1234.svc.us-west4-gcp-free.pinecone.io")) {
+		t.Errorf("unexpected url %s", got)
+	}
+
+	if _, err := runFunction(NewIndexHostUrlFunction(), types.StringUnknown(), types.StringValue("my-index"), types.StringValue(""), types.StringValue("us-west4-gcp-free")); err == nil {
+		t.Errorf("expected an error for an empty project name")
+	}
+}
+
+func TestMetadataConfigFunction(t *testing.T) {
+	fields := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("year"),
+		types.StringValue("genre"),
+		types.StringValue("year"),
+	})
+
+	got, err := runFunction(NewMetadataConfigFunction(), types.ObjectUnknown(metadataConfigAttributeTypes), fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := types.ObjectValueMust(metadataConfigAttributeTypes, map[string]attr.Value{
+		"indexed": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("genre"), types.StringValue("year")}),
+	})
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -2,9 +2,15 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -12,13 +18,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	datasources "github.com/thiskevinwang/terraform-provider-pinecone/internal/data-sources"
+	ephemeralresources "github.com/thiskevinwang/terraform-provider-pinecone/internal/ephemeral-resources"
 	resources "github.com/thiskevinwang/terraform-provider-pinecone/internal/resources"
 	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &pineconeProvider{}
+	_ provider.Provider                       = &pineconeProvider{}
+	_ provider.ProviderWithFunctions          = &pineconeProvider{}
+	_ provider.ProviderWithEphemeralResources = &pineconeProvider{}
 )
 
 func New(version string) func() provider.Provider {
@@ -39,6 +48,34 @@ type pineconeProviderModel struct {
 	ApiKey types.String `tfsdk:"apikey"`
 	// ex. us-west4-gcp-free
 	Environment types.String `tfsdk:"environment"`
+	// ex. grpc
+	DataPlaneTransport types.String `tfsdk:"data_plane_transport"`
+	// ex. 5
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	// ex. 4
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	// ex. /run/secrets/pinecone
+	ApiKeyFile types.String `tfsdk:"api_key_file"`
+	// ex. ["vault", "kv", "get", "-field=api_key", "secret/pinecone"]
+	ApiKeyCommand types.List `tfsdk:"api_key_command"`
+	// ex. staging
+	Profile types.String `tfsdk:"profile"`
+	// ex. ~/.pinecone/credentials
+	SharedCredentialsFile types.String `tfsdk:"shared_credentials_file"`
+	// ex. true
+	ValidateCredentials types.Bool `tfsdk:"validate_credentials"`
+	// ex. { team = "search", cost_center = "1234" }
+	DefaultTags types.Map `tfsdk:"default_tags"`
+	// ex. { "p1.x1" = 0.08 }
+	PodHourlyPrices types.Map `tfsdk:"pod_hourly_prices"`
+	// ex. 500
+	CostWarningThreshold types.Float64 `tfsdk:"cost_warning_threshold"`
+	// ex. uuid
+	ClientId types.String `tfsdk:"client_id"`
+	// ex. secret
+	ClientSecret types.String `tfsdk:"client_secret"`
+	// ex. uuid
+	ProjectId types.String `tfsdk:"project_id"`
 }
 
 // Metadata returns the provider type name.
@@ -76,11 +113,85 @@ resource "pinecone_index" "my-first-index" {
 				Required:            false,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API key. Conflicts with `apikey` and `api_key_command`.",
+				Optional:            true,
+				Required:            false,
+			},
+			"api_key_command": schema.ListAttribute{
+				MarkdownDescription: "A command, and its arguments, whose standard output is the API key, ex. `[\"vault\", \"kv\", \"get\", \"-field=api_key\", \"secret/pinecone\"]`. The command is run without a shell. Conflicts with `apikey` and `api_key_file`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Required:            false,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of a profile in the shared credentials file to read `api_key` and `environment` from. Takes precedence over the `PINECONE_API_KEY` and `PINECONE_ENVIRONMENT` environment variables. Will use the `PINECONE_PROFILE` environment variable if not set. When no profile is set, the `default` profile is used as a fallback.",
+				Optional:            true,
+				Required:            false,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the provider looks up the project of the API key while it is configured, so that an invalid API key or a wrong environment is reported up front instead of during the first operation. Defaults to `false`.",
+				Optional:            true,
+				Required:            false,
+			},
+			"default_tags": schema.MapAttribute{
+				MarkdownDescription: "Tags added to every taggable resource, ex. `pinecone_index` and `pinecone_collection`, for cost allocation and ownership. Tags set on a resource take precedence. The merged tags are exposed as `tags_all`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Required:            false,
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the shared credentials file. Will use the `PINECONE_SHARED_CREDENTIALS_FILE` environment variable if not set, and defaults to `~/.pinecone/credentials`.",
+				Optional:            true,
+				Required:            false,
+			},
 			"environment": schema.StringAttribute{
 				MarkdownDescription: "Will use the `PINECONE_ENVIRONMENT` environment variable if not set.",
 				Optional:            true,
 				Required:            false,
 			},
+			"data_plane_transport": schema.StringAttribute{
+				MarkdownDescription: "Transport used for vector operations against index hosts. One of `rest` (default) or `grpc`. gRPC connections are reused per index host.",
+				Optional:            true,
+				Required:            false,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum rate of requests sent to Pinecone, shared by all resources and data sources of this provider. Unlimited if not set.",
+				Optional:            true,
+				Required:            false,
+			},
+			"pod_hourly_prices": schema.MapAttribute{
+				MarkdownDescription: "Hourly price per pod, keyed by pod type, ex. `{ \"p1.x1\" = 0.08 }`. Overrides the list prices embedded in the provider when estimating the cost of indexes, ex. with negotiated prices.",
+				ElementType:         types.Float64Type,
+				Optional:            true,
+				Required:            false,
+			},
+			"cost_warning_threshold": schema.Float64Attribute{
+				MarkdownDescription: "When a plan increases the `estimated_monthly_cost` of an index by more than this amount, a warning is shown. Disabled if not set.",
+				Optional:            true,
+				Required:            false,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client id of a service account, used to authenticate with the admin API, ex. for `pinecone_api_key`. Requires `client_secret`. Will use the `PINECONE_CLIENT_ID` environment variable if not set.",
+				Optional:            true,
+				Required:            false,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret of the service account. Requires `client_id`. Will use the `PINECONE_CLIENT_SECRET` environment variable if not set.",
+				Optional:            true,
+				Required:            false,
+				Sensitive:           true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Scopes every resource and data source of this provider to a project, ex. the `id` of a `pinecone_project`. Requests are then authenticated with the service account instead of an API key. Requires `client_id` and `client_secret`. Will use the `PINECONE_PROJECT_ID` environment variable if not set.",
+				Optional:            true,
+				Required:            false,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests in flight at once, shared by all resources and data sources of this provider. Unlimited if not set.",
+				Optional:            true,
+				Required:            false,
+			},
 		},
 	}
 }
@@ -99,19 +210,39 @@ func (p *pineconeProvider) Configure(ctx context.Context, req provider.Configure
 	if config.ApiKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("apikey"),
-			"Summary (ApiKey)",
-			"Detail (ApiKey)",
+			"Unknown Pinecone API Key",
+			"The provider cannot create the Pinecone API client as there is an unknown configuration value for the Pinecone API key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the PINECONE_API_KEY environment variable.",
 		)
 	}
 
 	if config.Environment.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("environment"),
-			"Summary (Environment)",
-			"Detail (Environment)",
+			"Unknown Pinecone Environment",
+			"The provider cannot create the Pinecone API client as there is an unknown configuration value for the Pinecone environment. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the PINECONE_ENVIRONMENT environment variable.",
 		)
 	}
 
+	for name, value := range map[string]attr.Value{
+		"api_key_file":            config.ApiKeyFile,
+		"api_key_command":         config.ApiKeyCommand,
+		"profile":                 config.Profile,
+		"shared_credentials_file": config.SharedCredentialsFile,
+		"client_id":               config.ClientId,
+		"client_secret":           config.ClientSecret,
+		"project_id":              config.ProjectId,
+	} {
+		if value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unknown Pinecone credentials configuration",
+				fmt.Sprintf("The provider cannot be configured because %s depends on a value that is not known until apply.", name),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -121,33 +252,237 @@ func (p *pineconeProvider) Configure(ctx context.Context, req provider.Configure
 	apikey := os.Getenv("PINECONE_API_KEY")
 	environment := os.Getenv("PINECONE_ENVIRONMENT")
 
+	profile := os.Getenv("PINECONE_PROFILE")
+	credentialsFile := os.Getenv("PINECONE_SHARED_CREDENTIALS_FILE")
+
+	if !config.Profile.IsNull() {
+		profile = config.Profile.ValueString()
+	}
+
+	if !config.SharedCredentialsFile.IsNull() {
+		credentialsFile = config.SharedCredentialsFile.ValueString()
+	}
+
+	if credentialsFile == "" {
+		credentialsFile = defaultSharedCredentialsFile()
+	}
+
+	// A named profile takes precedence over environment variables, while the
+	// default profile only fills in whatever they leave unset.
+	if profile != "" {
+		credentials, err := loadProfile(credentialsFile, profile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Unable to load Pinecone profile",
+				fmt.Sprintf("Could not read profile %q from the shared credentials file: %s", profile, err),
+			)
+		} else {
+			if credentials.ApiKey != "" {
+				apikey = credentials.ApiKey
+			}
+			if credentials.Environment != "" {
+				environment = credentials.Environment
+			}
+		}
+	} else if apikey == "" || environment == "" {
+		credentials, err := loadProfile(credentialsFile, "default")
+		switch {
+		case err == nil:
+			if apikey == "" {
+				apikey = credentials.ApiKey
+			}
+			if environment == "" {
+				environment = credentials.Environment
+			}
+		case errors.Is(err, fs.ErrNotExist), errors.Is(err, errProfileNotFound):
+			// no shared credentials to fall back to
+		default:
+			resp.Diagnostics.AddWarning(
+				"Unable to load default Pinecone profile",
+				fmt.Sprintf("The shared credentials file could not be read and was ignored: %s", err),
+			)
+		}
+	}
+
+	apiKeySources := 0
+	for _, source := range []attr.Value{config.ApiKey, config.ApiKeyFile, config.ApiKeyCommand} {
+		if !source.IsNull() {
+			apiKeySources++
+		}
+	}
+
+	if apiKeySources > 1 {
+		resp.Diagnostics.AddError(
+			"Conflicting API key configuration",
+			"Only one of apikey, api_key_file and api_key_command may be set.",
+		)
+	}
+
 	if !config.ApiKey.IsNull() {
 		apikey = config.ApiKey.ValueString()
 	}
 
+	if !config.ApiKeyFile.IsNull() {
+		key, err := readApiKeyFile(config.ApiKeyFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"Unable to read API key file",
+				err.Error(),
+			)
+		}
+		apikey = key
+	}
+
+	if !config.ApiKeyCommand.IsNull() {
+		var args []string
+		resp.Diagnostics.Append(config.ApiKeyCommand.ElementsAs(ctx, &args, false)...)
+
+		key, err := runApiKeyCommand(ctx, args)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_command"),
+				"Unable to run API key command",
+				err.Error(),
+			)
+		}
+		apikey = key
+	}
+
 	if !config.Environment.IsNull() {
 		environment = config.Environment.ValueString()
 	}
 
+	clientId := os.Getenv("PINECONE_CLIENT_ID")
+	clientSecret := os.Getenv("PINECONE_CLIENT_SECRET")
+
+	if !config.ClientId.IsNull() {
+		clientId = config.ClientId.ValueString()
+	}
+
+	if !config.ClientSecret.IsNull() {
+		clientSecret = config.ClientSecret.ValueString()
+	}
+
+	if (clientId == "") != (clientSecret == "") {
+		resp.Diagnostics.AddError(
+			"Incomplete service account configuration",
+			"Both client_id and client_secret must be set to use the admin API. "+
+				"Set both in the configuration, or use the PINECONE_CLIENT_ID and PINECONE_CLIENT_SECRET environment variables.",
+		)
+	}
+
+	projectId := os.Getenv("PINECONE_PROJECT_ID")
+
+	if !config.ProjectId.IsNull() {
+		projectId = config.ProjectId.ValueString()
+	}
+
+	if projectId != "" && clientId == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_id"),
+			"Missing service account",
+			"Requests scoped to a project are authenticated with a service account. Set client_id and client_secret, "+
+				"or use the PINECONE_CLIENT_ID and PINECONE_CLIENT_SECRET environment variables.",
+		)
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if apikey == "" {
+	// a service account can manage projects and API keys without an API key,
+	// and authenticates every request when the provider is scoped to a project
+	if apikey == "" && clientId == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("apikey"),
-			"Missing (ApiKey)",
-			"Detail (ApiKey)",
+			"Missing Pinecone API Key",
+			"The provider cannot create the Pinecone API client as there is a missing or empty value for the Pinecone API key. "+
+				"Set the apikey, api_key_file, api_key_command or profile value in the configuration, use the PINECONE_API_KEY environment variable, "+
+				"add an api_key to the default profile of the shared credentials file, or configure a service account with client_id and client_secret. "+
+				"If any of these are already set, ensure the value is not empty. API keys are listed in the Pinecone console under API Keys.",
 		)
 	}
 
 	if environment == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("environment"),
-			"Missing (Environment)",
-			"Detail (Environment)",
+			"Missing Pinecone Environment",
+			"The provider cannot create the Pinecone API client as there is a missing or empty value for the Pinecone environment. "+
+				"Set the environment or profile value in the configuration, use the PINECONE_ENVIRONMENT environment variable, "+
+				"or add an environment to the default profile of the shared credentials file. "+
+				"If any of these are already set, ensure the value is not empty. The environment is shown next to the API key in the Pinecone console, ex. us-west4-gcp-free.",
+		)
+	}
+
+	transport := "rest"
+	if !config.DataPlaneTransport.IsNull() {
+		transport = config.DataPlaneTransport.ValueString()
+	}
+
+	if transport != "rest" && transport != "grpc" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data_plane_transport"),
+			"Invalid data plane transport",
+			fmt.Sprintf("Expected \"rest\" or \"grpc\", got: %q", transport),
+		)
+	}
+
+	requestsPerSecond := config.RequestsPerSecond.ValueFloat64()
+	maxConcurrentRequests := config.MaxConcurrentRequests.ValueInt64()
+
+	if requestsPerSecond < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid requests per second",
+			fmt.Sprintf("Expected a positive number, got: %v", requestsPerSecond),
+		)
+	}
+
+	if maxConcurrentRequests < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid max concurrent requests",
+			fmt.Sprintf("Expected a positive number, got: %d", maxConcurrentRequests),
 		)
 	}
 
+	podHourlyPrices := map[string]float64{}
+	if !config.PodHourlyPrices.IsNull() && !config.PodHourlyPrices.IsUnknown() {
+		resp.Diagnostics.Append(config.PodHourlyPrices.ElementsAs(ctx, &podHourlyPrices, false)...)
+	}
+	for podType, price := range podHourlyPrices {
+		if price < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pod_hourly_prices").AtMapKey(podType),
+				"Invalid pod price",
+				fmt.Sprintf("Expected a positive number, got: %v", price),
+			)
+		}
+	}
+
+	costWarningThreshold := config.CostWarningThreshold.ValueFloat64()
+	if costWarningThreshold < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cost_warning_threshold"),
+			"Invalid cost warning threshold",
+			fmt.Sprintf("Expected a positive number, got: %v", costWarningThreshold),
+		)
+	}
+
+	if config.DefaultTags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags"),
+			"Unknown default tags",
+			"The provider cannot be configured because default_tags depends on a value that is not known until apply.",
+		)
+	}
+
+	defaultTags := map[string]string{}
+	if !config.DefaultTags.IsNull() && !config.DefaultTags.IsUnknown() {
+		resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -158,15 +493,41 @@ func (p *pineconeProvider) Configure(ctx context.Context, req provider.Configure
 
 	tflog.Debug(ctx, "Creating client")
 
-	// TODO(kevinwang): create pinecone client?
-	client := services.Pinecone{
-		ApiKey:      apikey,
-		Environment: environment,
+	client := services.NewClient(apikey, environment)
+	client.DefaultTags = defaultTags
+	client.Prices = client.Prices.WithOverrides(podHourlyPrices)
+	client.CostWarningThreshold = costWarningThreshold
+
+	if clientId != "" {
+		client.ServiceAccount = &services.ServiceAccount{ClientId: clientId, ClientSecret: clientSecret}
+	}
+	client.ProjectId = projectId
+
+	if transport == "grpc" {
+		client.GrpcConns = services.NewGrpcConns()
+	}
+
+	// the limiter is shared by every resource and data source using the client
+	if requestsPerSecond > 0 || maxConcurrentRequests > 0 {
+		client.Limiter = services.NewLimiter(requestsPerSecond, maxConcurrentRequests)
+	}
+
+	if config.ValidateCredentials.ValueBool() {
+		tflog.Debug(ctx, "Validating credentials")
+
+		whoami, err := client.Whoami()
+		if err != nil {
+			resp.Diagnostics.Append(credentialsDiagnostic(environment, err))
+			return
+		}
+
+		tflog.Info(ctx, "Validated credentials", map[string]any{"project_name": whoami.ProjectName})
 	}
 
-	// TODO(kevinwang): Make the client available during DataSource and Resource type Configure methods.
+	// Make the client available during DataSource, Resource and EphemeralResource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 
 	tflog.Info(ctx, "Configured client", map[string]any{"success": true})
 }
@@ -175,6 +536,13 @@ func (p *pineconeProvider) Configure(ctx context.Context, req provider.Configure
 func (p *pineconeProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		datasources.NewCollectionDataSource,
+		datasources.NewWhoamiDataSource,
+		datasources.NewCostEstimateDataSource,
+		datasources.NewCapacityPlanDataSource,
+		datasources.NewApiKeysDataSource,
+		datasources.NewBackupsDataSource,
+		datasources.NewEmbeddingDataSource,
+		datasources.NewModelsDataSource,
 	}
 }
 
@@ -182,5 +550,28 @@ func (p *pineconeProvider) DataSources(_ context.Context) []func() datasource.Da
 func (p *pineconeProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewIndexResource,
+		resources.NewCollectionResource,
+		resources.NewVectorResource,
+		resources.NewApiKeyResource,
+		resources.NewProjectResource,
+		resources.NewBackupResource,
+		resources.NewImportResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *pineconeProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		ephemeralresources.NewCredentialsEphemeralResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *pineconeProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewPodTypeFunction,
+		NewParseIndexIdFunction,
+		NewIndexHostUrlFunction,
+		NewMetadataConfigFunction,
 	}
 }
@@ -0,0 +1,296 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiKeyResource{}
+	_ resource.ResourceWithConfigure   = &apiKeyResource{}
+	_ resource.ResourceWithImportState = &apiKeyResource{}
+)
+
+func NewApiKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// apiKeyResource is the resource implementation. Unlike other resources, its
+// requests are not logged, because the plan and state hold the secret value.
+type apiKeyResource struct {
+	// this client is set by the provider
+	client services.AdminPlane
+}
+
+// apiKeyResourceModel maps the resource schema data.
+type apiKeyResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	ProjectId types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	Roles     types.List   `tfsdk:"roles"`
+	Value     types.String `tfsdk:"value"`
+}
+
+// Metadata returns the resource type name.
+func (r *apiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "apiKeyResource.Metadata", map[string]any{"req": req, "resp": resp})
+
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "apiKeyResource.Schema", map[string]any{"req": req, "resp": resp})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages an API key of a project through the admin API. Requires the provider's ` + "`client_id` and `client_secret`" + `.
+
+Every attribute requires replacement, so a key is rotated with ` + "`terraform apply -replace`" + `. The secret ` + "`value`" + ` is only returned when the key is created, and is stored in the Terraform state.
+- See [API Docs](https://docs.pinecone.io/reference/api/2025-04/admin/create_api_key)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service generated identifier of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The id of the project the API key belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the API key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 80),
+				},
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "The roles granted to the API key. One or more of `ProjectEditor`, `ProjectViewer`, `ControlPlaneEditor`, `ControlPlaneViewer`, `DataPlaneEditor` and `DataPlaneViewer`. Defaults to `[\"ProjectEditor\"]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ProjectEditor")})),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(services.ApiKeyRoles...)),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The secret value of the API key. Null for imported keys.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "apiKeyResource.Configure", map[string]any{"req": req, "resp": resp})
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(services.AdminPlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.AdminPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create a new resource.
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "apiKeyResource.Create")
+	var plan apiKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roles []string
+	resp.Diagnostics.Append(plan.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.CreateApiKey(plan.ProjectId.ValueString(), services.CreateApiKeyRequest{
+		Name:  plan.Name.ValueString(),
+		Roles: roles,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create API key",
+			fmt.Sprintf("Failed to create API key: %s", err),
+		)
+		return
+	}
+
+	// log the response, without the secret value
+	tflog.Info(ctx, "CreateApiKey OK", map[string]any{"response": response.Key})
+
+	plan.Id = types.StringValue(response.Key.Id)
+	plan.Value = types.StringValue(response.Value)
+	resp.Diagnostics.Append(plan.setComputed(ctx, &response.Key)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read resource information.
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "apiKeyResource.Read")
+
+	var state apiKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := r.client.DescribeApiKey(state.Id.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Warn(ctx, "API key not found, removing it from the state", map[string]any{"api_key": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe API key",
+			err.Error(),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "DescribeApiKey OK", map[string]any{"response": *apiKey})
+
+	resp.Diagnostics.Append(state.setComputed(ctx, apiKey)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, every attribute requires replacement.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "apiKeyResource.Update")
+
+	var plan apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resource information.
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "apiKeyResource.Delete")
+
+	var state apiKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteApiKey(state.Id.ValueString())
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to delete API key",
+			fmt.Sprintf("Failed to delete API key: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "DeleteApiKey OK", map[string]any{"api_key": state.Id.ValueString()})
+}
+
+// ImportState imports an API key by id. Its secret value cannot be read back.
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "apiKeyResource.ImportState", map[string]any{"req": req, "resp": resp})
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), types.StringNull())...)
+}
+
+// setComputed copies the attributes reported by describe_api_key.
+func (m *apiKeyResourceModel) setComputed(ctx context.Context, apiKey *services.ApiKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ProjectId = types.StringValue(apiKey.ProjectId)
+	m.Name = types.StringValue(apiKey.Name)
+
+	// the service may return the roles in another order, which would
+	// otherwise replace the key
+	if !m.Roles.IsNull() && !m.Roles.IsUnknown() {
+		var roles []string
+		diags.Append(m.Roles.ElementsAs(ctx, &roles, false)...)
+		if sameRoles(roles, apiKey.Roles) {
+			return diags
+		}
+	}
+
+	roles, d := types.ListValueFrom(ctx, types.StringType, apiKey.Roles)
+	diags.Append(d...)
+	m.Roles = roles
+	return diags
+}
+
+// sameRoles reports whether a and b hold the same roles, in any order.
+func sameRoles(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := map[string]int{}
+	for _, role := range a {
+		count[role]++
+	}
+	for _, role := range b {
+		if count[role] == 0 {
+			return false
+		}
+		count[role]--
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeAdminPlane keeps API keys in memory, and records the keys created and
+// the project updates made by the resources.
+type fakeAdminPlane struct {
+	services.AdminPlane
+	apiKeys map[string]*services.ApiKey
+	created []services.CreateApiKeyRequest
+
+	projectUpdates []services.UpdateProjectRequest
+}
+
+func (f *fakeAdminPlane) CreateApiKey(projectId string, data services.CreateApiKeyRequest) (*services.CreateApiKeyResponse, error) {
+	f.created = append(f.created, data)
+	key := services.ApiKey{Id: "k1", Name: data.Name, ProjectId: projectId, Roles: data.Roles}
+	f.apiKeys[key.Id] = &key
+	return &services.CreateApiKeyResponse{Key: key, Value: "pcsk_secret"}, nil
+}
+
+func (f *fakeAdminPlane) DescribeApiKey(id string) (*services.ApiKey, error) {
+	key, ok := f.apiKeys[id]
+	if !ok {
+		return nil, &services.APIError{Operation: "DescribeApiKey", StatusCode: 404, Message: "not found"}
+	}
+	return key, nil
+}
+
+func TestApiKeyCreateAndRead(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeAdminPlane{apiKeys: map[string]*services.ApiKey{}}
+	r := &apiKeyResource{client: fake}
+
+	plan := testState(t, NewApiKeyResource(), map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, "proj"),
+		"name":       tftypes.NewValue(tftypes.String, "search"),
+		"roles": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "DataPlaneViewer"),
+		}),
+		"id":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"value": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created apiKeyResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.Id.ValueString() != "k1" || created.Value.ValueString() != "pcsk_secret" {
+		t.Errorf("expected the id and secret value in state, got %q %q", created.Id.ValueString(), created.Value.ValueString())
+	}
+	if len(fake.created) != 1 || fake.created[0].Roles[0] != "DataPlaneViewer" {
+		t.Errorf("unexpected create requests %+v", fake.created)
+	}
+
+	// the secret value is kept, because describe_api_key does not return it
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	var read apiKeyResourceModel
+	readResp.State.Get(ctx, &read)
+	if read.Value.ValueString() != "pcsk_secret" {
+		t.Errorf("expected the secret value to survive a refresh, got %q", read.Value.ValueString())
+	}
+
+	// a key deleted outside of Terraform is removed from state
+	delete(fake.apiKeys, "k1")
+	readResp = &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() || !readResp.State.Raw.IsNull() {
+		t.Errorf("expected the key to be removed from state, got %v", readResp.Diagnostics)
+	}
+}
+
+func TestApiKeyReadKeepsRoleOrder(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeAdminPlane{apiKeys: map[string]*services.ApiKey{
+		"k1": {Id: "k1", Name: "search", ProjectId: "proj", Roles: []string{"DataPlaneViewer", "ControlPlaneViewer"}},
+	}}
+	r := &apiKeyResource{client: fake}
+
+	roles := func(names ...string) tftypes.Value {
+		var values []tftypes.Value
+		for _, name := range names {
+			values = append(values, tftypes.NewValue(tftypes.String, name))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+	}
+
+	tests := []struct {
+		name  string
+		state tftypes.Value
+		want  string
+	}{
+		{"same roles in another order", roles("ControlPlaneViewer", "DataPlaneViewer"), `["ControlPlaneViewer","DataPlaneViewer"]`},
+		{"changed roles", roles("ProjectEditor"), `["DataPlaneViewer","ControlPlaneViewer"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testState(t, NewApiKeyResource(), map[string]tftypes.Value{
+				"id":    tftypes.NewValue(tftypes.String, "k1"),
+				"roles": tt.state,
+			})
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var read apiKeyResourceModel
+			resp.State.Get(ctx, &read)
+			if read.Roles.String() != tt.want {
+				t.Errorf("expected roles %s, got %s", tt.want, read.Roles)
+			}
+		})
+	}
+}
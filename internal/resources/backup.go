@@ -0,0 +1,372 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &backupResource{}
+	_ resource.ResourceWithConfigure   = &backupResource{}
+	_ resource.ResourceWithImportState = &backupResource{}
+)
+
+func NewBackupResource() resource.Resource {
+	return &backupResource{}
+}
+
+// backupResource is the resource implementation.
+type backupResource struct {
+	// this client is set by the provider
+	client services.BackupPlane
+}
+
+// backupResourceModel maps the resource schema data.
+type backupResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	SourceIndexName types.String `tfsdk:"source_index_name"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Status          types.String `tfsdk:"status"`
+	Dimension       types.Int64  `tfsdk:"dimension"`
+	Metric          types.String `tfsdk:"metric"`
+	RecordCount     types.Int64  `tfsdk:"record_count"`
+	NamespaceCount  types.Int64  `tfsdk:"namespace_count"`
+	SizeBytes       types.Int64  `tfsdk:"size_bytes"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *backupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "backupResource.Metadata", map[string]any{"req": req, "resp": resp})
+
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+// Schema defines the schema for the resource.
+func (r *backupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "backupResource.Schema", map[string]any{"req": req, "resp": resp})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a backup of a serverless index. Collections only apply to pod-based indexes; serverless indexes are copied with backups.
+
+Create an index from a backup with the ` + "`source_backup`" + ` attribute of ` + "`pinecone_index`" + `.
+- See [Back up an index](https://docs.pinecone.io/guides/manage-data/back-up-an-index)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service generated identifier of the backup.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_index_name": schema.StringAttribute{
+				Description: "The name of the serverless index to back up.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the backup.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the backup.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the backup.",
+				Computed:    true,
+			},
+			"dimension": schema.Int64Attribute{
+				Description: "The dimension of the vectors in the backup.",
+				Computed:    true,
+			},
+			"metric": schema.StringAttribute{
+				Description: "The distance metric of the backed up index.",
+				Computed:    true,
+			},
+			"record_count": schema.Int64Attribute{
+				Description: "The number of records in the backup.",
+				Computed:    true,
+			},
+			"namespace_count": schema.Int64Attribute{
+				Description: "The number of namespaces in the backup.",
+				Computed:    true,
+			},
+			"size_bytes": schema.Int64Attribute{
+				Description: "The size of the backup in bytes.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the backup was created, as an RFC 3339 timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *backupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "backupResource.Configure", map[string]any{"req": req, "resp": resp})
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(services.BackupPlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.BackupPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create a new resource.
+func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "backupResource.Create", map[string]any{"req": req, "resp": resp})
+	var plan backupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.CreateBackup(plan.SourceIndexName.ValueString(), services.CreateBackupRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create backup",
+			fmt.Sprintf("Failed to create backup: %s", err),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "CreateBackup OK", map[string]any{"response": *backup})
+
+	// save the id first, so that a backup which fails to become Ready is tainted rather than lost
+	plan.Id = types.StringValue(backup.Id)
+	plan.setComputed(backup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	backup, diags := waitForBackup(ctx, r.client, backup.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.setComputed(backup)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read resource information.
+func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "backupResource.Read", map[string]any{"req": req, "resp": resp})
+
+	var state backupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.DescribeBackup(state.Id.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Warn(ctx, "Backup not found, removing it from the state", map[string]any{"backup": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe backup",
+			err.Error(),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "DescribeBackup OK", map[string]any{"response": *backup})
+
+	state.SourceIndexName = types.StringValue(backup.SourceIndexName)
+	if backup.Name != "" {
+		state.Name = types.StringValue(backup.Name)
+	}
+	if backup.Description != "" {
+		state.Description = types.StringValue(backup.Description)
+	}
+	state.setComputed(backup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, every configurable attribute requires replacement.
+func (r *backupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "backupResource.Update", map[string]any{"req": req, "resp": resp})
+
+	var plan backupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resource information.
+func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "backupResource.Delete", map[string]any{"req": req, "resp": resp})
+
+	var state backupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBackup(state.Id.ValueString())
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to delete backup",
+			fmt.Sprintf("Failed to delete backup: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBackup OK", map[string]any{"backup": state.Id.ValueString()})
+}
+
+// ImportState imports a backup by id.
+func (r *backupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "backupResource.ImportState", map[string]any{"req": req, "resp": resp})
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// setComputed copies the attributes reported by describe_backup.
+func (m *backupResourceModel) setComputed(backup *services.Backup) {
+	m.Status = types.StringValue(backup.Status)
+	m.Dimension = types.Int64Value(backup.Dimension)
+	m.Metric = types.StringValue(backup.Metric)
+	m.RecordCount = types.Int64Value(backup.RecordCount)
+	m.NamespaceCount = types.Int64Value(backup.NamespaceCount)
+	m.SizeBytes = types.Int64Value(backup.SizeBytes)
+	m.CreatedAt = types.StringValue(backup.CreatedAt)
+}
+
+// waitForBackup polls the backup until it is Ready.
+func waitForBackup(ctx context.Context, client services.BackupPlane, id string) (*services.Backup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		backup, err := client.DescribeBackup(id)
+		if err != nil {
+			diags.AddError(
+				"Failed to poll backup",
+				fmt.Sprintf("Failed to describe backup: %s", err),
+			)
+			return nil, diags
+		}
+
+		switch backup.Status {
+		case "Ready":
+			return backup, diags
+		case "Failed":
+			diags.AddError(
+				"Backup failed",
+				fmt.Sprintf("Backup %q of index %q failed.", id, backup.SourceIndexName),
+			)
+			return nil, diags
+		}
+
+		tflog.Debug(ctx, "Waiting for backup", map[string]any{"backup": id, "status": backup.Status})
+
+		select {
+		case <-ticker.C: // keep polling
+		case <-ctx.Done():
+			diags.AddError(
+				"Failed to poll backup",
+				fmt.Sprintf("Stopped waiting for backup %q to be Ready: %s", id, ctx.Err()),
+			)
+			return nil, diags
+		}
+	}
+}
+
+// waitForRestoreJob polls the restore job until it is Completed.
+func waitForRestoreJob(ctx context.Context, client services.BackupPlane, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := client.DescribeRestoreJob(id)
+		if err != nil {
+			diags.AddError(
+				"Failed to poll restore job",
+				fmt.Sprintf("Failed to describe restore job: %s", err),
+			)
+			return diags
+		}
+
+		switch job.Status {
+		case "Completed":
+			return diags
+		case "Failed":
+			diags.AddError(
+				"Restore job failed",
+				fmt.Sprintf("Restore job %q of backup %q into index %q failed.", id, job.BackupId, job.TargetIndexName),
+			)
+			return diags
+		}
+
+		tflog.Debug(ctx, "Waiting for restore job", map[string]any{"restore_job": id, "status": job.Status, "percent_complete": job.PercentComplete})
+
+		select {
+		case <-ticker.C: // keep polling
+		case <-ctx.Done():
+			diags.AddError(
+				"Failed to poll restore job",
+				fmt.Sprintf("Stopped waiting for restore job %q to complete: %s", id, ctx.Err()),
+			)
+			return diags
+		}
+	}
+}
@@ -0,0 +1,372 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeBackupPlane reports successive backup and restore job statuses, and
+// creates restored indexes in the fake controller. A backup without statuses
+// is not found.
+type fakeBackupPlane struct {
+	services.BackupPlane
+	controlPlane *fakeControlPlane
+	// statuses returned by successive DescribeBackup and DescribeRestoreJob calls
+	backupStatuses     []string
+	restoreJobStatuses []string
+	created            []services.CreateBackupRequest
+	deleted            []string
+	restored           []services.CreateIndexFromBackupRequest
+}
+
+func (f *fakeBackupPlane) CreateBackup(indexName string, data services.CreateBackupRequest) (*services.Backup, error) {
+	f.created = append(f.created, data)
+	return &services.Backup{Id: "b1", SourceIndexName: indexName, Name: data.Name, Status: "Initializing"}, nil
+}
+
+func (f *fakeBackupPlane) DeleteBackup(id string) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func (f *fakeBackupPlane) DescribeBackup(id string) (*services.Backup, error) {
+	if len(f.backupStatuses) == 0 {
+		return nil, &services.APIError{Operation: "DescribeBackup", StatusCode: 404, Message: "not found"}
+	}
+	status := f.backupStatuses[0]
+	if len(f.backupStatuses) > 1 {
+		f.backupStatuses = f.backupStatuses[1:]
+	}
+	return &services.Backup{Id: id, SourceIndexName: "movies", Status: status, Dimension: 1536, Metric: "dotproduct"}, nil
+}
+
+func (f *fakeBackupPlane) CreateIndexFromBackup(id string, data services.CreateIndexFromBackupRequest) (*services.CreateIndexFromBackupResponse, error) {
+	f.restored = append(f.restored, data)
+	f.controlPlane.addModel(&services.IndexModel{Name: data.Name, Dimension: 1536, Metric: "dotproduct", DeletionProtection: data.DeletionProtection})
+	return &services.CreateIndexFromBackupResponse{RestoreJobId: "rj1"}, nil
+}
+
+func (f *fakeBackupPlane) DescribeRestoreJob(id string) (*services.RestoreJob, error) {
+	status := f.restoreJobStatuses[0]
+	if len(f.restoreJobStatuses) > 1 {
+		f.restoreJobStatuses = f.restoreJobStatuses[1:]
+	}
+	return &services.RestoreJob{Id: id, BackupId: "b1", Status: status}, nil
+}
+
+func TestBackupCreate(t *testing.T) {
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = 10 * time.Second }()
+
+	backups := &fakeBackupPlane{backupStatuses: []string{"Initializing", "Ready"}}
+	r := &backupResource{client: backups}
+
+	plan := testState(t, NewBackupResource(), map[string]tftypes.Value{
+		"source_index_name": tftypes.NewValue(tftypes.String, "movies"),
+		"name":              tftypes.NewValue(tftypes.String, "nightly"),
+	})
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(backups.created) != 1 || backups.created[0].Name != "nightly" {
+		t.Errorf("unexpected create requests %+v", backups.created)
+	}
+	if len(backups.backupStatuses) != 1 {
+		t.Errorf("expected to wait for the backup, %v statuses left", backups.backupStatuses)
+	}
+
+	var created backupResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &created)...)
+	if created.Id.ValueString() != "b1" || created.Status.ValueString() != "Ready" || created.Dimension.ValueInt64() != 1536 || created.Metric.ValueString() != "dotproduct" {
+		t.Errorf("unexpected backup %+v", created)
+	}
+}
+
+func TestBackupRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		statuses    []string
+		wantRemoved bool
+	}{
+		{"ready", []string{"Ready"}, false},
+		{"not found", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &backupResource{client: &fakeBackupPlane{backupStatuses: tt.statuses}}
+
+			state := testState(t, NewBackupResource(), map[string]tftypes.Value{
+				"id":                tftypes.NewValue(tftypes.String, "b1"),
+				"source_index_name": tftypes.NewValue(tftypes.String, "movies"),
+			})
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Errorf("expected removed %v, got state %v", tt.wantRemoved, resp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestBackupDelete(t *testing.T) {
+	backups := &fakeBackupPlane{}
+	r := &backupResource{client: backups}
+
+	state := testState(t, NewBackupResource(), map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, "b1"),
+		"source_index_name": tftypes.NewValue(tftypes.String, "movies"),
+	})
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(backups.deleted) != 1 || backups.deleted[0] != "b1" {
+		t.Errorf("unexpected deletes %v", backups.deleted)
+	}
+}
+
+func TestBackupImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &backupResource{client: &fakeBackupPlane{backupStatuses: []string{"Ready"}}}
+
+	empty := testState(t, NewBackupResource(), nil)
+	importResp := &resource.ImportStateResponse{State: empty}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "b1"}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected import diagnostics: %v", importResp.Diagnostics)
+	}
+
+	// the imported id is refreshed by Read
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var imported backupResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &imported)...)
+	if imported.Id.ValueString() != "b1" || imported.SourceIndexName.ValueString() != "movies" || imported.Status.ValueString() != "Ready" {
+		t.Errorf("unexpected backup %+v", imported)
+	}
+}
+
+func TestIndexCreateFromBackup(t *testing.T) {
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = 10 * time.Second }()
+
+	controlPlane := &fakeControlPlane{indexes: map[string]*services.DescribeIndexResponse{}}
+	backups := &fakeBackupPlane{
+		controlPlane:       controlPlane,
+		backupStatuses:     []string{"Initializing", "Ready"},
+		restoreJobStatuses: []string{"Pending", "InProgress", "Completed"},
+	}
+	r := &indexResource{client: controlPlane, backups: backups, serverless: controlPlane}
+
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":                tftypes.NewValue(tftypes.String, "movies-restored"),
+		"dimension":           tftypes.NewValue(tftypes.Number, 1536),
+		"source_backup":       tftypes.NewValue(tftypes.String, "b1"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: state.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(controlPlane.calls) != 0 {
+		t.Errorf("expected no CreateIndex call, got %v", controlPlane.calls)
+	}
+	if len(backups.restored) != 1 || backups.restored[0].Name != "movies-restored" || backups.restored[0].DeletionProtection != "disabled" {
+		t.Errorf("unexpected restores %+v", backups.restored)
+	}
+	if len(backups.restoreJobStatuses) != 1 {
+		t.Errorf("expected to wait for the restore job, %v statuses left", backups.restoreJobStatuses)
+	}
+
+	var created indexResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &created)...)
+	if created.Host.ValueString() != "movies-restored.svc.test-env.pinecone.io" {
+		t.Errorf("unexpected host %q", created.Host.ValueString())
+	}
+	// restored indexes are serverless, so the controller does not know them
+	if len(controlPlane.indexes) != 0 {
+		t.Errorf("expected no controller index, got %v", controlPlane.indexes)
+	}
+}
+
+func TestIndexCreateFromFailedRestore(t *testing.T) {
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = 10 * time.Second }()
+
+	controlPlane := &fakeControlPlane{indexes: map[string]*services.DescribeIndexResponse{}}
+	backups := &fakeBackupPlane{
+		controlPlane:       controlPlane,
+		backupStatuses:     []string{"Ready"},
+		restoreJobStatuses: []string{"InProgress", "Failed"},
+	}
+	r := &indexResource{client: controlPlane, backups: backups, serverless: controlPlane}
+
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":                tftypes.NewValue(tftypes.String, "movies-restored"),
+		"dimension":           tftypes.NewValue(tftypes.Number, 1536),
+		"source_backup":       tftypes.NewValue(tftypes.String, "b1"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: state.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+	}, resp)
+
+	if len(resp.Diagnostics.Errors()) != 1 || resp.Diagnostics.Errors()[0].Summary() != "Restore job failed" {
+		t.Errorf("expected the restore job to fail, got %v", resp.Diagnostics)
+	}
+
+	// the restored index exists, so it is kept in state to be tainted
+	var created indexResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &created)...)
+	if created.Id.ValueString() != "test-env/movies-restored" || created.Name.ValueString() != "movies-restored" {
+		t.Errorf("expected the index to be saved before waiting, got id %q", created.Id.ValueString())
+	}
+}
+
+func TestIndexModifyPlanSourceBackup(t *testing.T) {
+	tests := []struct {
+		name       string
+		metric     interface{}
+		wantErr    string
+		wantMetric string
+	}{
+		{"metric from backup", nil, "", "dotproduct"},
+		{"matching metric", "dotproduct", "", "dotproduct"},
+		{"mismatched metric", "cosine", "Metric does not match source backup", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &indexResource{client: &fakeControlPlane{}, backups: &fakeBackupPlane{backupStatuses: []string{"Ready"}}}
+
+			config := testState(t, NewIndexResource(), map[string]tftypes.Value{
+				"name":          tftypes.NewValue(tftypes.String, "movies-restored"),
+				"source_backup": tftypes.NewValue(tftypes.String, "b1"),
+				"metric":        tftypes.NewValue(tftypes.String, tt.metric),
+			})
+			// the defaults of metric and the pod attributes
+			metric := "cosine"
+			if tt.metric != nil {
+				metric = tt.metric.(string)
+			}
+			planned := testState(t, NewIndexResource(), map[string]tftypes.Value{
+				"name":          tftypes.NewValue(tftypes.String, "movies-restored"),
+				"source_backup": tftypes.NewValue(tftypes.String, "b1"),
+				"dimension":     tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"metric":        tftypes.NewValue(tftypes.String, metric),
+				"pods":          tftypes.NewValue(tftypes.Number, 1),
+				"replicas":      tftypes.NewValue(tftypes.Number, 1),
+				"pod_type":      tftypes.NewValue(tftypes.String, "p1.x1"),
+			})
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: planned.Schema, Raw: tftypes.NewValue(planned.Raw.Type(), nil)},
+			}, resp)
+
+			if tt.wantErr != "" {
+				if len(resp.Diagnostics.Errors()) != 1 || resp.Diagnostics.Errors()[0].Summary() != tt.wantErr {
+					t.Errorf("expected error %q, got %v", tt.wantErr, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got indexResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+			if got.Metric.ValueString() != tt.wantMetric || got.Dimension.ValueInt64() != 1536 {
+				t.Errorf("expected metric %s and dimension 1536, got %s and %s", tt.wantMetric, got.Metric, got.Dimension)
+			}
+			// a restored index is serverless
+			if !got.Pods.IsNull() || !got.Replicas.IsNull() || !got.Shards.IsNull() || !got.PodType.IsNull() || !got.EstimatedMonthlyCost.IsNull() {
+				t.Errorf("expected no pods and no estimated cost, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestIndexModifyPlanRestoredKeepsMetric(t *testing.T) {
+	ctx := context.Background()
+	// the backup may since have been deleted, so it must not be looked up
+	r := &indexResource{client: &fakeControlPlane{}, backups: &fakeBackupPlane{}}
+
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":          tftypes.NewValue(tftypes.String, "movies-restored"),
+		"source_backup": tftypes.NewValue(tftypes.String, "b1"),
+		"dimension":     tftypes.NewValue(tftypes.Number, 1536),
+		"metric":        tftypes.NewValue(tftypes.String, "dotproduct"),
+	})
+	config := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":          tftypes.NewValue(tftypes.String, "movies-restored"),
+		"source_backup": tftypes.NewValue(tftypes.String, "b1"),
+	})
+	planned := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":          tftypes.NewValue(tftypes.String, "movies-restored"),
+		"source_backup": tftypes.NewValue(tftypes.String, "b1"),
+		"dimension":     tftypes.NewValue(tftypes.Number, 1536),
+		"metric":        tftypes.NewValue(tftypes.String, "cosine"),
+		"pods":          tftypes.NewValue(tftypes.Number, 1),
+		"replicas":      tftypes.NewValue(tftypes.Number, 1),
+		"pod_type":      tftypes.NewValue(tftypes.String, "p1.x1"),
+	})
+	plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		Plan:   plan,
+		State:  state,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got indexResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+	if got.Metric.ValueString() != "dotproduct" {
+		t.Errorf("expected the metric to be kept from the state, got %s", got.Metric)
+	}
+	if !got.Pods.IsNull() || !got.PodType.IsNull() {
+		t.Errorf("expected no pods, got %s of %s", got.Pods, got.PodType)
+	}
+}
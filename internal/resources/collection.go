@@ -0,0 +1,329 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &collectionResource{}
+	_ resource.ResourceWithConfigure   = &collectionResource{}
+	_ resource.ResourceWithImportState = &collectionResource{}
+	_ resource.ResourceWithModifyPlan  = &collectionResource{}
+)
+
+func NewCollectionResource() resource.Resource {
+	return &collectionResource{}
+}
+
+// collectionResource is the resource implementation.
+type collectionResource struct {
+	// this client is set by the provider
+	client services.ControlPlane
+	// the provider's default_tags
+	defaultTags map[string]string
+}
+
+// collectionResourceModel maps the resource schema data.
+type collectionResourceModel struct {
+	Id          types.String `tfsdk:"id"` // for TF
+	Name        types.String `tfsdk:"name"`
+	Source      types.String `tfsdk:"source"`
+	Dimension   types.Int64  `tfsdk:"dimension"`
+	Size        types.Int64  `tfsdk:"size"`
+	VectorCount types.Int64  `tfsdk:"vector_count"`
+	Status      types.String `tfsdk:"status"`
+	Tags        types.Map    `tfsdk:"tags"`
+	TagsAll     types.Map    `tfsdk:"tags_all"`
+}
+
+// Metadata returns the resource type name.
+func (r *collectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "collectionResource.Metadata", map[string]any{"req": req, "resp": resp})
+
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+// Schema defines the schema for the resource.
+func (r *collectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "collectionResource.Schema", map[string]any{"req": req, "resp": resp})
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a collection, a static copy of an index. Collections do not support tags, so tags and tags_all are only kept in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service generated identifier.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the collection to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The name of the index to create the collection from.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					// the source of an imported collection is unknown, so setting it must not replace the collection
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the source index of a collection requires replacement.",
+						"Changing the source index of a collection requires replacement.",
+					),
+				},
+			},
+			"dimension": schema.Int64Attribute{
+				Description: "The dimension of the vectors stored in the collection.",
+				Computed:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "The size of the collection in bytes.",
+				Computed:    true,
+			},
+			"vector_count": schema.Int64Attribute{
+				Description: "The number of vectors stored in the collection.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the collection.",
+				Computed:    true,
+			},
+		},
+	}
+
+	for name, attribute := range tagsAttributes("Tags of the collection, ex. for cost allocation. Collections do not support tags, so these are only kept in the Terraform state.") {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+// ModifyPlan plans tags_all.
+func (r *collectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "collectionResource.ModifyPlan", map[string]any{"req": req, "resp": resp})
+
+	modifyPlanTagsAll(ctx, r.defaultTags, req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *collectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "collectionResource.Configure", map[string]any{"req": req, "resp": resp})
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(services.ControlPlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.ControlPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	if c, ok := req.ProviderData.(*services.Client); ok {
+		r.defaultTags = c.DefaultTags
+	}
+}
+
+// Create a new resource.
+func (r *collectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "collectionResource.Create", map[string]any{"req": req, "resp": resp})
+	var plan collectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	response, err := r.client.CreateCollection(services.CreateCollectionBodyParams{
+		Name:   name,
+		Source: plan.Source.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create collection",
+			fmt.Sprintf("Failed to create collection: %s", err),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "CreateCollection OK", map[string]any{"response": *response})
+
+	collection, diags := waitForCollection(ctx, r.client, name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", r.client.Environment(), name))
+	plan.setComputed(collection)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read resource information.
+func (r *collectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "collectionResource.Read", map[string]any{"req": req, "resp": resp})
+
+	var state collectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection, err := r.client.DescribeCollection(state.Name.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Warn(ctx, "Collection not found, removing it from the state", map[string]any{"collection": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe collection",
+			err.Error(),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "DescribeCollection OK", map[string]any{"response": *collection})
+
+	state.setComputed(collection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource information. Everything but the tags requires replacement,
+// and tags are only kept in state.
+func (r *collectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "collectionResource.Update", map[string]any{"req": req, "resp": resp})
+
+	var plan collectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resource information.
+func (r *collectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "collectionResource.Delete", map[string]any{"req": req, "resp": resp})
+
+	var state collectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	delCollResp, err := r.client.DeleteCollection(state.Name.ValueString())
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to delete collection",
+			fmt.Sprintf("Failed to delete collection: %s", err),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "DeleteCollection OK", map[string]any{"response": delCollResp})
+}
+
+func (r *collectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "collectionResource.ImportState", map[string]any{"req": req, "resp": resp})
+
+	// accept both the collection name and the env/name id
+	name := indexNameFromId(req.ID)
+
+	collection, err := r.client.DescribeCollection(name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe collection",
+			err.Error(),
+		)
+		return
+	}
+
+	state := collectionResourceModel{
+		Id:   types.StringValue(fmt.Sprintf("%s/%s", r.client.Environment(), name)),
+		Name: types.StringValue(name),
+		// the source index is not reported by describe_collection
+		Source:  types.StringNull(),
+		Tags:    types.MapNull(types.StringType),
+		TagsAll: types.MapValueMust(types.StringType, nil),
+	}
+	state.setComputed(collection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// setComputed copies the attributes reported by describe_collection.
+func (m *collectionResourceModel) setComputed(collection *services.DescribeCollectionResponse) {
+	m.Dimension = types.Int64Value(collection.Dimension)
+	m.Size = types.Int64Value(collection.Size)
+	m.VectorCount = types.Int64Value(collection.VecotrCount)
+	m.Status = types.StringValue(collection.Status)
+}
+
+// waitForCollection polls the collection until it is Ready.
+func waitForCollection(ctx context.Context, client services.ControlPlane, name string) (*services.DescribeCollectionResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		collection, err := client.DescribeCollection(name)
+		if err != nil {
+			diags.AddError(
+				"Failed to poll collection",
+				fmt.Sprintf("Failed to describe collection: %s", err),
+			)
+			return nil, diags
+		}
+
+		if collection.Status == "Ready" {
+			return collection, diags
+		}
+
+		tflog.Debug(ctx, "Waiting for collection", map[string]any{"collection": name, "status": collection.Status})
+
+		select {
+		case <-ticker.C: // keep polling
+		case <-ctx.Done():
+			diags.AddError(
+				"Failed to poll collection",
+				fmt.Sprintf("Stopped waiting for collection %q to be Ready: %s", name, ctx.Err()),
+			)
+			return nil, diags
+		}
+	}
+}
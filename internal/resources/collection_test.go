@@ -0,0 +1,126 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCollectionCreate(t *testing.T) {
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = 10 * time.Second }()
+
+	fake := &fakeControlPlane{
+		collectionStatuses:    []string{"Initializing", "Ready"},
+		collectionVectorCount: 10,
+		collectionDimension:   8,
+	}
+	r := &collectionResource{client: fake}
+
+	plan := testState(t, NewCollectionResource(), map[string]tftypes.Value{
+		"name":   tftypes.NewValue(tftypes.String, "movies-snapshot"),
+		"source": tftypes.NewValue(tftypes.String, "movies"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"team": tftypes.NewValue(tftypes.String, "search"),
+		}),
+		"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"team": tftypes.NewValue(tftypes.String, "search"),
+		}),
+	})
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	want := []string{"CreateCollection movies", "DescribeCollection", "DescribeCollection"}
+	if !reflect.DeepEqual(fake.calls, want) {
+		t.Errorf("expected to wait for the collection, got calls %v", fake.calls)
+	}
+
+	var created collectionResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &created)...)
+	if created.Id.ValueString() != "test-env/movies-snapshot" {
+		t.Errorf("unexpected id %q", created.Id.ValueString())
+	}
+	if created.Status.ValueString() != "Ready" || created.VectorCount.ValueInt64() != 10 || created.Dimension.ValueInt64() != 8 {
+		t.Errorf("unexpected computed attributes %+v", created)
+	}
+	if len(created.Tags.Elements()) != 1 {
+		t.Errorf("expected the tags to be kept in state, got %v", created.Tags)
+	}
+}
+
+func TestCollectionRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		statuses    []string
+		wantRemoved bool
+	}{
+		{"ready", []string{"Ready"}, false},
+		{"not found", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeControlPlane{collectionStatuses: tt.statuses, collectionVectorCount: 10}
+			r := &collectionResource{client: fake}
+
+			state := testState(t, NewCollectionResource(), map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, "test-env/movies-snapshot"),
+				"name":         tftypes.NewValue(tftypes.String, "movies-snapshot"),
+				"source":       tftypes.NewValue(tftypes.String, "movies"),
+				"vector_count": tftypes.NewValue(tftypes.Number, 4),
+			})
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Fatalf("expected removed %v, got state %v", tt.wantRemoved, resp.State.Raw)
+			}
+			if tt.wantRemoved {
+				return
+			}
+
+			var read collectionResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &read)...)
+			if read.VectorCount.ValueInt64() != 10 || read.Status.ValueString() != "Ready" {
+				t.Errorf("expected the collection to be refreshed, got %+v", read)
+			}
+		})
+	}
+}
+
+func TestCollectionDelete(t *testing.T) {
+	fake := &fakeControlPlane{}
+	r := &collectionResource{client: fake}
+
+	state := testState(t, NewCollectionResource(), map[string]tftypes.Value{
+		"id":     tftypes.NewValue(tftypes.String, "test-env/movies-snapshot"),
+		"name":   tftypes.NewValue(tftypes.String, "movies-snapshot"),
+		"source": tftypes.NewValue(tftypes.String, "movies"),
+	})
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !reflect.DeepEqual(fake.calls, []string{"DeleteCollection movies-snapshot"}) {
+		t.Errorf("unexpected calls %v", fake.calls)
+	}
+}
@@ -0,0 +1,427 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &importResource{}
+	_ resource.ResourceWithConfigure   = &importResource{}
+	_ resource.ResourceWithImportState = &importResource{}
+)
+
+func NewImportResource() resource.Resource {
+	return &importResource{}
+}
+
+// importResource is the resource implementation.
+type importResource struct {
+	// these clients are set by the provider; bulk import is only supported by
+	// serverless indexes, so their host is described by the global control plane
+	client  services.ServerlessPlane
+	imports services.ImportPlane
+}
+
+// importResourceModel maps the resource schema data.
+type importResourceModel struct {
+	Id              types.String  `tfsdk:"id"`
+	IndexName       types.String  `tfsdk:"index_name"`
+	Uri             types.String  `tfsdk:"uri"`
+	IntegrationId   types.String  `tfsdk:"integration_id"`
+	ErrorMode       types.String  `tfsdk:"error_mode"`
+	Status          types.String  `tfsdk:"status"`
+	PercentComplete types.Float64 `tfsdk:"percent_complete"`
+	RecordsImported types.Int64   `tfsdk:"records_imported"`
+	CreatedAt       types.String  `tfsdk:"created_at"`
+	FinishedAt      types.String  `tfsdk:"finished_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *importResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "importResource.Metadata", map[string]any{"req": req, "resp": resp})
+
+	resp.TypeName = req.ProviderTypeName + "_import"
+}
+
+// Schema defines the schema for the resource.
+func (r *importResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "importResource.Schema", map[string]any{"req": req, "resp": resp})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Imports records from Parquet files in object storage into a serverless index, and waits for the import to complete.
+
+Destroying the resource cancels the import if it is still running. Records that were already imported are kept.
+- See [Import records](https://docs.pinecone.io/guides/index-data/import-data)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service generated identifier of the import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index_name": schema.StringAttribute{
+				Description: "The name of the serverless index to import the records into.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				MarkdownDescription: "The bucket prefix holding the Parquet files, ex. `s3://bucket/movies/` or `gs://bucket/movies/`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(s3|gs)://`), "must be an s3:// or gs:// URI"),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				Description: "The id of the storage integration that grants access to a private bucket.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"error_mode": schema.StringAttribute{
+				MarkdownDescription: "What to do when a record cannot be imported. `abort` fails the import, `continue` skips the record. Defaults to `abort`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("abort"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("abort", "continue"),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the import.",
+				Computed:    true,
+			},
+			"percent_complete": schema.Float64Attribute{
+				Description: "How much of the import is done, from 0 to 100.",
+				Computed:    true,
+			},
+			"records_imported": schema.Int64Attribute{
+				Description: "The number of records imported.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the import was started, as an RFC 3339 timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"finished_at": schema.StringAttribute{
+				Description: "When the import finished, as an RFC 3339 timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *importResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "importResource.Configure", map[string]any{"req": req, "resp": resp})
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the clients from the provider data
+	client, ok := req.ProviderData.(services.ServerlessPlane)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.ServerlessPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	imports, ok := req.ProviderData.(services.ImportPlane)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.ImportPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	r.imports = imports
+}
+
+// Create starts a new import and waits for it to complete.
+func (r *importResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "importResource.Create", map[string]any{"req": req, "resp": resp})
+	var plan importResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index, err := r.client.DescribeIndexModel(plan.IndexName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe index",
+			fmt.Sprintf("Failed to describe index: %s", err),
+		)
+		return
+	}
+	host := index.Host
+
+	started, err := r.imports.StartImport(host, services.StartImportRequest{
+		Uri:           plan.Uri.ValueString(),
+		IntegrationId: plan.IntegrationId.ValueString(),
+		ErrorMode:     &services.ImportErrorMode{OnError: plan.ErrorMode.ValueString()},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to start import",
+			fmt.Sprintf("Failed to start import: %s", err),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "StartImport OK", map[string]any{"response": *started})
+
+	// save the id first, so that an import which fails is tainted rather than lost
+	plan.Id = types.StringValue(started.Id)
+	plan.Status = types.StringValue("Pending")
+	plan.PercentComplete = types.Float64Value(0)
+	plan.RecordsImported = types.Int64Value(0)
+	plan.CreatedAt = types.StringNull()
+	plan.FinishedAt = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	imp, diags := waitForImport(ctx, r.imports, host, started.Id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.setComputed(imp)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read resource information.
+func (r *importResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "importResource.Read", map[string]any{"req": req, "resp": resp})
+
+	var state importResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index, err := r.client.DescribeIndexModel(state.IndexName.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Warn(ctx, "Index not found, removing the import from the state", map[string]any{"index": state.IndexName.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe index",
+			err.Error(),
+		)
+		return
+	}
+
+	imp, err := r.imports.DescribeImport(index.Host, state.Id.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Warn(ctx, "Import not found, removing it from the state", map[string]any{"import": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe import",
+			err.Error(),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "DescribeImport OK", map[string]any{"response": *imp})
+
+	state.Uri = types.StringValue(imp.Uri)
+	state.setComputed(imp)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called for the import itself, every configurable attribute requires replacement.
+func (r *importResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "importResource.Update", map[string]any{"req": req, "resp": resp})
+
+	var plan importResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete cancels the import if it is still running.
+func (r *importResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "importResource.Delete", map[string]any{"req": req, "resp": resp})
+
+	var state importResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index, err := r.client.DescribeIndexModel(state.IndexName.ValueString())
+	if services.IsNotFound(err) {
+		// the import went away with its index
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe index",
+			fmt.Sprintf("Failed to describe index: %s", err),
+		)
+		return
+	}
+
+	imp, err := r.imports.DescribeImport(index.Host, state.Id.ValueString())
+	if services.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe import",
+			fmt.Sprintf("Failed to describe import: %s", err),
+		)
+		return
+	}
+
+	if imp.Status != "Pending" && imp.Status != "InProgress" {
+		tflog.Info(ctx, "Import already finished, nothing to cancel", map[string]any{"import": imp.Id, "status": imp.Status})
+		return
+	}
+
+	err = r.imports.CancelImport(index.Host, state.Id.ValueString())
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to cancel import",
+			fmt.Sprintf("Failed to cancel import: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "CancelImport OK", map[string]any{"import": state.Id.ValueString()})
+}
+
+// ImportState imports an import by index name and id, ex. movies/101.
+func (r *importResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "importResource.ImportState", map[string]any{"req": req, "resp": resp})
+
+	indexName, id, ok := strings.Cut(req.ID, "/")
+	if !ok || indexName == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import id",
+			fmt.Sprintf("Expected an id in the format index_name/import_id, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index_name"), indexName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("error_mode"), "abort")...)
+}
+
+// setComputed copies the attributes reported by describe_import.
+func (m *importResourceModel) setComputed(imp *services.Import) {
+	m.Status = types.StringValue(imp.Status)
+	m.PercentComplete = types.Float64Value(imp.PercentComplete)
+	m.RecordsImported = types.Int64Value(imp.RecordsImported)
+	m.CreatedAt = types.StringNull()
+	if imp.CreatedAt != "" {
+		m.CreatedAt = types.StringValue(imp.CreatedAt)
+	}
+	m.FinishedAt = types.StringNull()
+	if imp.FinishedAt != "" {
+		m.FinishedAt = types.StringValue(imp.FinishedAt)
+	}
+}
+
+// waitForImport polls the import until it is Completed, logging its progress.
+func waitForImport(ctx context.Context, client services.ImportPlane, host string, id string) (*services.Import, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		imp, err := client.DescribeImport(host, id)
+		if err != nil {
+			diags.AddError(
+				"Failed to poll import",
+				fmt.Sprintf("Failed to describe import: %s", err),
+			)
+			return nil, diags
+		}
+
+		switch imp.Status {
+		case "Completed":
+			return imp, diags
+		case "Failed":
+			diags.AddError(
+				"Import failed",
+				fmt.Sprintf("Import %q of %q failed after importing %d records: %s", id, imp.Uri, imp.RecordsImported, imp.Error),
+			)
+			return nil, diags
+		case "Cancelled":
+			diags.AddError(
+				"Import cancelled",
+				fmt.Sprintf("Import %q of %q was cancelled after importing %d records.", id, imp.Uri, imp.RecordsImported),
+			)
+			return nil, diags
+		}
+
+		tflog.Info(ctx, "Waiting for import", map[string]any{"import": id, "status": imp.Status, "percent_complete": imp.PercentComplete, "records_imported": imp.RecordsImported})
+
+		select {
+		case <-ticker.C: // keep polling
+		case <-ctx.Done():
+			diags.AddError(
+				"Failed to poll import",
+				fmt.Sprintf("Stopped waiting for import %q to complete: %s", id, ctx.Err()),
+			)
+			return nil, diags
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeImportPlane reports successive import statuses and records
+// cancellations.
+type fakeImportPlane struct {
+	// statuses returned by successive DescribeImport calls
+	statuses  []string
+	started   []services.StartImportRequest
+	cancelled []string
+}
+
+func (f *fakeImportPlane) StartImport(host string, data services.StartImportRequest) (*services.StartImportResponse, error) {
+	f.started = append(f.started, data)
+	return &services.StartImportResponse{Id: "101"}, nil
+}
+
+func (f *fakeImportPlane) ListImports(host string) ([]services.Import, error) {
+	return nil, nil
+}
+
+func (f *fakeImportPlane) DescribeImport(host string, id string) (*services.Import, error) {
+	status := f.statuses[0]
+	if len(f.statuses) > 1 {
+		f.statuses = f.statuses[1:]
+	}
+	imp := &services.Import{Id: id, Uri: "s3://bucket/movies/", Status: status}
+	switch status {
+	case "Completed":
+		imp.PercentComplete, imp.RecordsImported = 100, 1000
+	case "Failed":
+		imp.Error = "invalid parquet schema"
+	}
+	return imp, nil
+}
+
+func (f *fakeImportPlane) CancelImport(host string, id string) error {
+	f.cancelled = append(f.cancelled, id)
+	return nil
+}
+
+// moviesControlPlane describes the serverless index movies.
+func moviesControlPlane() *fakeControlPlane {
+	controlPlane := &fakeControlPlane{}
+	controlPlane.addModel(&services.IndexModel{Name: "movies"})
+	return controlPlane
+}
+
+func TestImportCreate(t *testing.T) {
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = 10 * time.Second }()
+
+	plan := testState(t, NewImportResource(), map[string]tftypes.Value{
+		"index_name": tftypes.NewValue(tftypes.String, "movies"),
+		"uri":        tftypes.NewValue(tftypes.String, "s3://bucket/movies/"),
+		"error_mode": tftypes.NewValue(tftypes.String, "continue"),
+	})
+
+	tests := []struct {
+		name     string
+		statuses []string
+		wantErr  string
+	}{
+		{"completed", []string{"Pending", "InProgress", "Completed"}, ""},
+		{"failed", []string{"InProgress", "Failed"}, "Import failed"},
+		{"cancelled", []string{"Cancelled"}, "Import cancelled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imports := &fakeImportPlane{statuses: tt.statuses}
+			r := &importResource{client: moviesControlPlane(), imports: imports}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+			r.Create(context.Background(), resource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if len(imports.started) != 1 || imports.started[0].ErrorMode.OnError != "continue" {
+				t.Errorf("unexpected start requests %+v", imports.started)
+			}
+
+			var created importResourceModel
+			resp.State.Get(context.Background(), &created)
+			if created.Id.ValueString() != "101" {
+				t.Errorf("expected the import id in state, got %q", created.Id.ValueString())
+			}
+
+			if tt.wantErr == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				if created.Status.ValueString() != "Completed" || created.RecordsImported.ValueInt64() != 1000 {
+					t.Errorf("unexpected state %+v", created)
+				}
+				return
+			}
+			if len(resp.Diagnostics.Errors()) != 1 || resp.Diagnostics.Errors()[0].Summary() != tt.wantErr {
+				t.Errorf("expected %q, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestImportDeleteCancelsRunningImport(t *testing.T) {
+	state := testState(t, NewImportResource(), map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "101"),
+		"index_name": tftypes.NewValue(tftypes.String, "movies"),
+		"uri":        tftypes.NewValue(tftypes.String, "s3://bucket/movies/"),
+		"error_mode": tftypes.NewValue(tftypes.String, "abort"),
+	})
+
+	tests := []struct {
+		status     string
+		wantCancel bool
+	}{
+		{"Pending", true},
+		{"InProgress", true},
+		{"Completed", false},
+		{"Failed", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			imports := &fakeImportPlane{statuses: []string{tt.status}}
+			r := &importResource{client: moviesControlPlane(), imports: imports}
+
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if cancelled := len(imports.cancelled) == 1; cancelled != tt.wantCancel {
+				t.Errorf("expected cancel %v, got %v", tt.wantCancel, imports.cancelled)
+			}
+		})
+	}
+}
@@ -3,16 +3,28 @@ package resources
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
@@ -20,32 +32,110 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &indexResource{}
-	_ resource.ResourceWithConfigure   = &indexResource{}
-	_ resource.ResourceWithImportState = &indexResource{}
+	_ resource.Resource                   = &indexResource{}
+	_ resource.ResourceWithConfigure      = &indexResource{}
+	_ resource.ResourceWithImportState    = &indexResource{}
+	_ resource.ResourceWithModifyPlan     = &indexResource{}
+	_ resource.ResourceWithValidateConfig = &indexResource{}
 )
 
 func NewIndexResource() resource.Resource {
 	return &indexResource{}
 }
 
+// How often to poll while waiting for an index or collection to become ready.
+var pollInterval = 10 * time.Second
+
+// How long a clone may take to catch up with its source collection.
+var vectorCountTimeout = 30 * time.Minute
+
 // indexResource is the resource implementation.
 type indexResource struct {
-	// this client is set by the provider
-	client services.Pinecone
+	// these clients are set by the provider
+	client     services.ControlPlane
+	dataPlane  services.DataPlane
+	backups    services.BackupPlane
+	embed      services.EmbedPlane
+	serverless services.ServerlessPlane
+	// the provider's default_tags
+	defaultTags map[string]string
+	// used to estimate the cost of the index
+	prices               *services.PriceTable
+	costWarningThreshold float64
 }
 
 // indexResourceModel maps the resource schema data.
 // - "github.com/hashicorp/terraform-plugin-framework/types"
 type indexResourceModel struct {
-	Id        types.String `tfsdk:"id"` // for TF
-	Name      types.String `tfsdk:"name"`
-	Dimension types.Int64  `tfsdk:"dimension"`
-	Metric    types.String `tfsdk:"metric"`
-	Replicas  types.Int64  `tfsdk:"replicas"`
-	Pods      types.Int64  `tfsdk:"pods"`
-	// Shards    types.Number  `tfsdk:"shards"`
-	SourceCollection types.String `tfsdk:"source_collection"`
+	Id                   types.String  `tfsdk:"id"` // for TF
+	Name                 types.String  `tfsdk:"name"`
+	NamePrefix           types.String  `tfsdk:"name_prefix"`
+	Dimension            types.Int64   `tfsdk:"dimension"`
+	Metric               types.String  `tfsdk:"metric"`
+	Replicas             types.Int64   `tfsdk:"replicas"`
+	Pods                 types.Int64   `tfsdk:"pods"`
+	Shards               types.Int64   `tfsdk:"shards"`
+	PodType              types.String  `tfsdk:"pod_type"`
+	MetadataConfig       types.Object  `tfsdk:"metadata_config"`
+	SourceCollection     types.String  `tfsdk:"source_collection"`
+	SourceBackup         types.String  `tfsdk:"source_backup"`
+	Embed                types.Object  `tfsdk:"embed"`
+	Host                 types.String  `tfsdk:"host"`
+	DeletionProtection   types.Bool    `tfsdk:"deletion_protection"`
+	SnapshotOnDestroy    types.Bool    `tfsdk:"snapshot_on_destroy"`
+	CloneOnReplace       types.Bool    `tfsdk:"clone_on_replace"`
+	Tags                 types.Map     `tfsdk:"tags"`
+	TagsAll              types.Map     `tfsdk:"tags_all"`
+	EstimatedMonthlyCost types.Float64 `tfsdk:"estimated_monthly_cost"`
+}
+
+// metadataConfigModel maps the metadata_config nested attribute.
+type metadataConfigModel struct {
+	Indexed types.List `tfsdk:"indexed"`
+}
+
+// Index names generated from name_prefix end in a timestamp, leaving this
+// much of the 45 characters for the prefix.
+const maxNamePrefixLength = 45 - len("-20060102150405")
+
+// The largest dimension supported by pod-based indexes.
+const maxDimension = 20000
+
+var (
+	// Index names consist of lowercase alphanumeric characters and '-', and
+	// start and end with an alphanumeric character.
+	indexNameRegexp       = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	indexNamePrefixRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	// ex. p1.x2, or starter in the free environment
+	podTypeRegexp = regexp.MustCompile(fmt.Sprintf(`^((%s)\.(%s)|%s)$`, strings.Join(podFamilies, "|"), strings.Join(podSizes, "|"), StarterPodType))
+)
+
+// A pod type is a pod family and size joined by '.', ex. p1.x2, except for
+// the starter pods of the free environment.
+var (
+	podFamilies = []string{"s1", "p1", "p2"}
+	podSizes    = []string{"x1", "x2", "x4", "x8"}
+)
+
+const StarterPodType = "starter"
+
+// PodFamilies returns the pod families, ex. p1.
+func PodFamilies() []string {
+	return append([]string{}, podFamilies...)
+}
+
+// PodSizes returns the pod sizes, ex. x2.
+func PodSizes() []string {
+	return append([]string{}, podSizes...)
+}
+
+// indexNameFromId returns the name of an index or collection from its id,
+// {environment}/{name}, or from the bare name.
+func indexNameFromId(id string) string {
+	if i := strings.LastIndex(id, "/"); i >= 0 {
+		return id[i+1:]
+	}
+	return id
 }
 
 // Metadata returns the resource type name.
@@ -70,37 +160,525 @@ func (r *indexResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the index to be created. The maximum length is 45 characters.",
-				Required:    true,
+				Description: "The name of the index to be created. The maximum length is 45 characters. Exactly one of name or name_prefix must be set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 45),
+					stringvalidator.RegexMatches(indexNameRegexp, "must consist of lowercase alphanumeric characters or '-', and start and end with an alphanumeric character"),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Creates a unique name beginning with this prefix, ex. {name_prefix}-20231004120000, so that a replacement index can be created before the old one is destroyed. The maximum length is 30 characters.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, maxNamePrefixLength),
+					stringvalidator.RegexMatches(indexNamePrefixRegexp, "must consist of lowercase alphanumeric characters or '-', and start with an alphanumeric character"),
+				},
 			},
 			"dimension": schema.Int64Attribute{
-				Description: "The dimensions of the vectors to be inserted in the index",
-				Required:    true,
+				Description: "The dimensions of the vectors to be inserted in the index. Between 1 and 20000. Required unless source_collection or source_backup is set, in which case it defaults to, and must match, the dimension of the collection or backup. Computed from the model when embed is set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxDimension),
+				},
 			},
 			"metric": schema.StringAttribute{
-				Description: "The distance metric to be used for similarity search. You can use 'euclidean', 'cosine', or 'dotproduct'.",
+				Description: "The distance metric to be used for similarity search. You can use 'euclidean', 'cosine', or 'dotproduct'. When embed or source_backup is set, it defaults to the metric of the model or backup.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("cosine"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("euclidean", "cosine", "dotproduct"),
+				},
 			},
 			"replicas": schema.Int64Attribute{
-				Description: "The number of replicas. Replicas duplicate your index. They provide higher availability and throughput.",
+				Description: "The number of replicas. Replicas duplicate your index. They provide higher availability and throughput. Null for serverless indexes, ie. restored from a source_backup or with embed.",
 				Optional:    true,
 				Computed:    true,
 				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 			"pods": schema.Int64Attribute{
-				Description: "The number of pods for the index to use,including replicas.",
+				Description: "The number of pods for the index to use,including replicas. Must be a multiple of replicas. Pods are added or removed in place by changing replicas; changing the number of shards replaces the index. Null for serverless indexes, ie. restored from a source_backup or with embed.",
 				Optional:    true,
 				Computed:    true,
 				Default:     int64default.StaticInt64(1),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						podsChangeShards,
+						"Changing the number of shards replaces the index.",
+						"Changing the number of shards replaces the index.",
+					),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"shards": schema.Int64Attribute{
+				Description: "The number of shards, ie. pods divided by replicas. Null for serverless indexes.",
+				Computed:    true,
+			},
+			"pod_type": schema.StringAttribute{
+				Description: "The type of pod to use. One of s1, p1, or p2 appended with . and one of x1, x2, x4, or x8. Changing it replaces the index. Null for serverless indexes, ie. restored from a source_backup or with embed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("p1.x1"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(podTypeRegexp, fmt.Sprintf("must be one of %s appended with . and one of %s, ex. p1.x1, or %s", strings.Join(podFamilies, ", "), strings.Join(podSizes, ", "), StarterPodType)),
+				},
+			},
+			"metadata_config": schema.SingleNestedAttribute{
+				Description: "Configuration for the behavior of Pinecone's internal metadata index. By default, all metadata is indexed; when metadata_config is present, only specified metadata fields are indexed. Changing it replaces the index.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"indexed": schema.ListAttribute{
+						Description: "The metadata fields to index.",
+						ElementType: types.StringType,
+						Required:    true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
 			},
 			"source_collection": schema.StringAttribute{
 				Description: "The name of the collection to create an index from",
 				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_backup": schema.StringAttribute{
+				Description: "The id of a backup to create the index from, ex. the id of a pinecone_backup. The restored index is serverless, with the dimension and metric of the backup. It is only available once the restore job that populates it is Completed. Conflicts with source_collection, clone_on_replace, pods, replicas and pod_type.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"embed": embedAttribute(),
+			"estimated_monthly_cost": schema.Float64Attribute{
+				Description: "The estimated monthly cost of the pods of the index, from the provider's price table and pod_hourly_prices. Null if the pod type has no price.",
+				Computed:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: "The host of the index, used by the data plane.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether the index is protected from deletion. While enabled, destroying or replacing the index fails; set it to false and apply first. Also enabled on the index itself in environments that support deletion protection.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"snapshot_on_destroy": schema.BoolAttribute{
+				Description: "Whether to snapshot the index into a collection named {name}-{timestamp} before it is destroyed or replaced. The index is only deleted once the collection is Ready. Like deletion_protection, this must be applied before the destroy.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"clone_on_replace": schema.BoolAttribute{
+				Description: "Whether a new index copies the vectors of the index it replaces. Requires name_prefix and lifecycle { create_before_destroy = true }: on create, the other index named {name_prefix}-{timestamp} is snapshot into a collection and the new index is created from it. The new index, and its host, are only available once it is Ready and holds as many vectors as the collection.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
 			},
 		},
 	}
+
+	for name, attribute := range tagsAttributes("Tags attached to the index, ex. for cost allocation. Sent to environments that support index tags.") {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+// ModifyPlan plans tags_all, shards, the estimated cost and the metric of an
+// embed model, and checks that a new source_collection or source_backup can
+// be used by the index.
+func (r *indexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "indexResource.ModifyPlan", map[string]any{"req": req, "resp": resp})
+
+	modifyPlanTagsAll(ctx, r.defaultTags, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan indexResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Pods.IsUnknown() && !plan.Replicas.IsUnknown() && plan.Replicas.ValueInt64() > 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("shards"), plan.Pods.ValueInt64()/plan.Replicas.ValueInt64())...)
+	}
+
+	// indexes restored from a backup or with embed are serverless, so they
+	// have no pods to price
+	if plan.podBased() {
+		resp.Diagnostics.Append(r.modifyPlanEstimatedCost(ctx, plan, req, resp)...)
+	} else {
+		resp.Diagnostics.Append(modifyPlanServerless(ctx, req, resp)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the collection or backup of an existing index may since have been
+	// deleted, so only look it up when the index is going to be created from it
+	var sourceCollection, sourceBackup types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("source_collection"), &sourceCollection)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("source_backup"), &sourceBackup)...)
+	}
+	newSourceCollection := !plan.SourceCollection.IsNull() && !plan.SourceCollection.IsUnknown() && !plan.SourceCollection.Equal(sourceCollection) && r.client != nil
+	newSourceBackup := !plan.SourceBackup.IsNull() && !plan.SourceBackup.IsUnknown() && !plan.SourceBackup.Equal(sourceBackup) && r.backups != nil
+	if !newSourceCollection && !newSourceBackup {
+		return
+	}
+
+	var configDimension types.Int64
+	var configMetric types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("dimension"), &configDimension)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metric"), &configMetric)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newSourceCollection {
+		resp.Diagnostics.Append(r.modifyPlanSourceCollection(ctx, plan.SourceCollection.ValueString(), configDimension, resp)...)
+	}
+	if newSourceBackup {
+		resp.Diagnostics.Append(r.modifyPlanSourceBackup(ctx, plan.SourceBackup.ValueString(), configDimension, configMetric, resp)...)
+	}
+}
+
+// modifyPlanServerless plans the pod attributes of a serverless index, and
+// its estimated cost, as null rather than their defaults. The metric is
+// taken from the backup or model unless it is configured, so it is kept
+// from the state once the index exists.
+func modifyPlanServerless(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("pods"), types.Int64Null())...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("replicas"), types.Int64Null())...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("shards"), types.Int64Null())...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("pod_type"), types.StringNull())...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("estimated_monthly_cost"), types.Float64Null())...)
+
+	var configMetric types.String
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("metric"), &configMetric)...)
+	if diags.HasError() || !configMetric.IsNull() {
+		return diags
+	}
+
+	metric := types.StringUnknown()
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("metric"), &metric)...)
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("metric"), metric)...)
+	return diags
+}
+
+// modifyPlanEstimatedCost plans estimated_monthly_cost, and warns when it
+// increases by more than the provider's cost_warning_threshold.
+func (r *indexResource) modifyPlanEstimatedCost(ctx context.Context, plan indexResourceModel, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.PodType.IsUnknown() || plan.Pods.IsUnknown() {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("estimated_monthly_cost"), types.Float64Unknown())...)
+		return diags
+	}
+
+	planned := r.estimatedMonthlyCost(ctx, plan.PodType, plan.Pods)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("estimated_monthly_cost"), planned)...)
+	if r.costWarningThreshold <= 0 || planned.IsNull() {
+		return diags
+	}
+
+	// a new index, including a replacement, adds its whole cost
+	current := 0.0
+	if !req.State.Raw.IsNull() {
+		var state indexResourceModel
+		diags.Append(req.State.Get(ctx, &state)...)
+		if diags.HasError() {
+			return diags
+		}
+		current = r.estimatedMonthlyCost(ctx, state.PodType, state.Pods).ValueFloat64()
+	}
+
+	prices := r.priceTable()
+	if increase := planned.ValueFloat64() - current; increase > r.costWarningThreshold {
+		diags.AddAttributeWarning(
+			path.Root("estimated_monthly_cost"),
+			"Index cost increase",
+			fmt.Sprintf("The estimated monthly cost of the index increases by %.2f %s, from %.2f to %.2f %s (price table %s). "+
+				"This exceeds the cost_warning_threshold of %.2f %s.",
+				increase, prices.Currency, current, planned.ValueFloat64(), prices.Currency, prices.Version, r.costWarningThreshold, prices.Currency),
+		)
+	}
+	return diags
+}
+
+// priceTable returns the prices configured by the provider, or the list
+// prices embedded in the provider.
+func (r *indexResource) priceTable() *services.PriceTable {
+	if r.prices == nil {
+		return services.DefaultPriceTable()
+	}
+	return r.prices
+}
+
+// estimatedMonthlyCost returns the estimated cost of the pods, or null if the
+// pod type has no price.
+func (r *indexResource) estimatedMonthlyCost(ctx context.Context, podType types.String, pods types.Int64) types.Float64 {
+	if podType.IsNull() || pods.IsNull() {
+		return types.Float64Null()
+	}
+
+	cost, err := r.priceTable().MonthlyCost(podType.ValueString(), pods.ValueInt64())
+	if err != nil {
+		tflog.Debug(ctx, "Unable to estimate the cost of the index", map[string]any{"error": err.Error()})
+		return types.Float64Null()
+	}
+	return types.Float64Value(cost)
+}
+
+// modifyPlanSourceCollection checks that the collection is Ready and that its
+// dimension matches the configured one, or plans the dimension of the
+// collection when none is configured.
+func (r *indexResource) modifyPlanSourceCollection(ctx context.Context, name string, configDimension types.Int64, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	collection, err := r.client.DescribeCollection(name)
+	if err != nil {
+		summary := "Failed to describe source collection"
+		if services.IsNotFound(err) {
+			summary = "Source collection not found"
+		}
+		diags.AddAttributeError(
+			path.Root("source_collection"),
+			summary,
+			fmt.Sprintf("Failed to describe collection %q: %s", name, err),
+		)
+		return diags
+	}
+
+	if collection.Status != "Ready" {
+		diags.AddAttributeError(
+			path.Root("source_collection"),
+			"Source collection not Ready",
+			fmt.Sprintf("Collection %q is %s. An index can only be created from a Ready collection.", name, collection.Status),
+		)
+	}
+
+	diags.Append(modifyPlanSourceDimension(ctx, "collection", name, collection.Dimension, configDimension, resp)...)
+	return diags
+}
+
+// modifyPlanSourceBackup checks that the backup is Ready and that its
+// dimension and metric match the configured ones, or plans those of the
+// backup when none are configured.
+func (r *indexResource) modifyPlanSourceBackup(ctx context.Context, id string, configDimension types.Int64, configMetric types.String, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	backup, err := r.backups.DescribeBackup(id)
+	if err != nil {
+		summary := "Failed to describe source backup"
+		if services.IsNotFound(err) {
+			summary = "Source backup not found"
+		}
+		diags.AddAttributeError(
+			path.Root("source_backup"),
+			summary,
+			fmt.Sprintf("Failed to describe backup %q: %s", id, err),
+		)
+		return diags
+	}
+
+	// a backup that is still Initializing is waited for when the index is created
+	if backup.Status == "Failed" {
+		diags.AddAttributeError(
+			path.Root("source_backup"),
+			"Source backup failed",
+			fmt.Sprintf("Backup %q of index %q failed. An index can only be created from a Ready backup.", id, backup.SourceIndexName),
+		)
+	}
+
+	diags.Append(modifyPlanSourceDimension(ctx, "backup", id, backup.Dimension, configDimension, resp)...)
+
+	// the restored index has the metric of the backup
+	if backup.Metric == "" {
+		return diags
+	}
+	if configMetric.IsNull() {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("metric"), backup.Metric)...)
+	} else if !configMetric.IsUnknown() && configMetric.ValueString() != backup.Metric {
+		diags.AddAttributeError(
+			path.Root("metric"),
+			"Metric does not match source backup",
+			fmt.Sprintf("The index metric is %s, but backup %q was taken of an index with metric %s. "+
+				"Set metric = %q, or omit it to use the metric of the backup.", configMetric.ValueString(), id, backup.Metric, backup.Metric),
+		)
+	}
+	return diags
+}
+
+// modifyPlanSourceDimension plans the dimension of the collection or backup
+// an index is created from when none is configured, or checks that the
+// configured one matches it.
+func modifyPlanSourceDimension(ctx context.Context, kind string, source string, dimension int64, configDimension types.Int64, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if configDimension.IsNull() {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("dimension"), dimension)...)
+		return diags
+	}
+
+	if !configDimension.IsUnknown() && configDimension.ValueInt64() != dimension {
+		diags.AddAttributeError(
+			path.Root("dimension"),
+			"Dimension does not match source "+kind,
+			fmt.Sprintf("The index dimension is %d, but %s %q holds vectors with dimension %d. "+
+				"Set dimension = %d, or omit it to use the dimension of the %s.", configDimension.ValueInt64(), kind, source, dimension, dimension, kind),
+		)
+	}
+	return diags
+}
+
+// ValidateConfig checks the combinations of attributes the schema cannot express.
+func (r *indexResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	tflog.Debug(ctx, "indexResource.ValidateConfig", map[string]any{"req": req, "resp": resp})
+
+	var config indexResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Name.IsNull() && config.NamePrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Missing index name",
+			"Exactly one of name or name_prefix must be set.",
+		)
+	}
+	if !config.Name.IsNull() && !config.NamePrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_prefix"),
+			"Conflicting index names",
+			"Exactly one of name or name_prefix must be set.",
+		)
+	}
+
+	if config.Dimension.IsNull() && config.SourceCollection.IsNull() && config.SourceBackup.IsNull() && config.Embed.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dimension"),
+			"Missing dimension",
+			"dimension is required unless the index is created from a source_collection or source_backup, or for an embed model.",
+		)
+	}
+
+	if !config.Embed.IsNull() {
+		if !config.Dimension.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("dimension"),
+				"Conflicting dimension",
+				"The dimension of an index with embed is the dimension of its model, so dimension cannot be set.",
+			)
+		}
+		if !config.SourceCollection.IsNull() || !config.SourceBackup.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("embed"),
+				"Conflicting index sources",
+				"An index with embed is created for its model, so source_collection and source_backup cannot be set.",
+			)
+		}
+		resp.Diagnostics.Append(config.validateServerless("An index with embed")...)
+	}
+
+	if !config.SourceBackup.IsNull() && !config.SourceCollection.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_backup"),
+			"Conflicting index sources",
+			"An index is created from at most one of source_collection and source_backup.",
+		)
+	}
+	if !config.SourceBackup.IsNull() {
+		resp.Diagnostics.Append(config.validateServerless("An index restored from a source_backup")...)
+	}
+
+	// pods = shards × replicas; unset values default to 1
+	if !config.Pods.IsUnknown() && !config.Replicas.IsUnknown() {
+		pods, replicas := int64(1), int64(1)
+		if !config.Pods.IsNull() {
+			pods = config.Pods.ValueInt64()
+		}
+		if !config.Replicas.IsNull() {
+			replicas = config.Replicas.ValueInt64()
+		}
+		if replicas > 0 && pods%replicas != 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pods"),
+				"Invalid number of pods",
+				fmt.Sprintf("pods must be a multiple of replicas, since every shard of the index is replicated: got %d pods for %d replicas. "+
+					"Set pods to the number of shards times %d.", pods, replicas, replicas),
+			)
+		}
+	}
+
+	if config.CloneOnReplace.ValueBool() {
+		if config.NamePrefix.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("clone_on_replace"),
+				"Missing name prefix",
+				"clone_on_replace requires name_prefix, so that the replacement can be created while the old index still exists.",
+			)
+		}
+		if !config.SourceCollection.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("clone_on_replace"),
+				"Conflicting source collection",
+				"clone_on_replace creates the index from a snapshot of the index it replaces, so source_collection cannot be set.",
+			)
+		}
+		if !config.SourceBackup.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("clone_on_replace"),
+				"Conflicting source backup",
+				"clone_on_replace creates the index from a snapshot of the index it replaces, so source_backup cannot be set.",
+			)
+		}
+		if !config.Embed.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("clone_on_replace"),
+				"Conflicting embed",
+				"clone_on_replace copies vectors through a collection, which indexes with embed do not support.",
+			)
+		}
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -110,19 +688,44 @@ func (r *indexResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	// extract the client from the provider data
-	client, ok := req.ProviderData.(services.Pinecone)
+	// extract the clients from the provider data
+	client, ok := req.ProviderData.(services.ControlPlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.ControlPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
 
+	dataPlane, ok := req.ProviderData.(services.DataPlane)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected pinecone.Pinecone, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected pinecone.DataPlane, got: %T", req.ProviderData),
 		)
 
 		return
 	}
 
 	r.client = client
+	r.dataPlane = dataPlane
+	if backups, ok := req.ProviderData.(services.BackupPlane); ok {
+		r.backups = backups
+	}
+	if embed, ok := req.ProviderData.(services.EmbedPlane); ok {
+		r.embed = embed
+	}
+	if serverless, ok := req.ProviderData.(services.ServerlessPlane); ok {
+		r.serverless = serverless
+	}
+	if c, ok := req.ProviderData.(*services.Client); ok {
+		r.defaultTags = c.DefaultTags
+		r.prices = c.Prices
+		r.costWarningThreshold = c.CostWarningThreshold
+	}
 }
 
 // Create a new resource.
@@ -139,50 +742,145 @@ func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Generate API request body from plan
 	name := plan.Name.ValueString()
+	if !plan.NamePrefix.IsNull() {
+		name = generatedIndexName(plan.NamePrefix.ValueString(), time.Now())
+		plan.Name = types.StringValue(name)
+	}
 	dimension := plan.Dimension.ValueInt64()
 	metric := plan.Metric.ValueString()
 	sourceCollection := plan.SourceCollection.ValueString()
 
-	// Create new index
-	response, err := r.client.CreateIndex(services.CreateIndexBodyParams{
-		Name:             name,
-		Dimension:        dimension,
-		Metric:           metric,
-		SourceCollection: sourceCollection,
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to create index",
-			fmt.Sprintf("Failed to create index: %s", err),
-		)
+	metadataConfig, diags := plan.metadataConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+	tags, diags := tagsMap(ctx, plan.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	// poll the describe index endpoint until the index is ready
-	// Poll every n seconds
-	ticker := time.NewTicker(10 * time.Second)
-	shouldRetry := true
-	for shouldRetry {
-		diRes, err := r.client.DescribeIndex(name)
+
+	// when replacing an index, copy its vectors through a collection
+	predecessor := ""
+	var clone *services.DescribeCollectionResponse
+	if plan.CloneOnReplace.ValueBool() {
+		predecessor, diags = r.findPredecessor(plan.NamePrefix.ValueString(), name)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if predecessor != "" {
+			clone, diags = r.snapshot(ctx, predecessor)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			sourceCollection = clone.Name
+		}
+	}
+
+	restoreJobId := ""
+	if !plan.Embed.IsNull() {
+		resp.Diagnostics.Append(r.createForModel(ctx, &plan, tags)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if !plan.SourceBackup.IsNull() {
+		restoreJobId, diags = r.restore(ctx, name, plan.SourceBackup.ValueString(), tags, plan.DeletionProtection.ValueBool())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Create new index
+		response, err := r.client.CreateIndex(services.CreateIndexBodyParams{
+			Name:             name,
+			Dimension:        dimension,
+			Metric:           metric,
+			Pods:             plan.Pods.ValueInt64(),
+			Replicas:         plan.Replicas.ValueInt64(),
+			PodType:          plan.PodType.ValueString(),
+			MetadataConfig:   metadataConfig,
+			SourceCollection: sourceCollection,
+			Tags:             tags,
+		})
+
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Failed to poll index",
-				fmt.Sprintf("Failed to describe index: %s", err),
+				"Failed to create index",
+				fmt.Sprintf("Failed to create index: %s", err),
 			)
 			return
 		}
 
-		if diRes.Status.State == "Ready" || diRes.Status.Ready {
-			shouldRetry = false
-		} else {
-			<-ticker.C // keep polling
+		// log the response
+		tflog.Info(ctx, "CreateIndex OK: %s", map[string]any{"response": *response})
+	}
+
+	// save the id first, so that an index which fails to be restored, to
+	// become Ready or to catch up with its clone is tainted rather than lost
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", r.client.Environment(), name))
+	resp.Diagnostics.Append(plan.setCreated(ctx, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if restoreJobId != "" {
+		resp.Diagnostics.Append(waitForRestoreJob(ctx, r.backups, restoreJobId)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	// log the response
-	tflog.Info(ctx, "CreateIndex OK: %s", map[string]any{"response": *response})
+	// serverless indexes are only managed by the global control plane
+	if !plan.podBased() {
+		index, diags := r.waitForIndexModel(ctx, name)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", r.client.Environment, name))
+		plan.Host = types.StringValue(index.Host)
+		// the metric of a restored index is only known once it is created
+		if plan.Metric.IsUnknown() {
+			plan.Metric = types.StringValue(index.Metric)
+		}
+		if plan.Dimension.IsUnknown() {
+			plan.Dimension = types.Int64Value(index.Dimension)
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// poll the describe index endpoint until the index is ready
+	diRes, diags := r.waitForIndex(ctx, name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if clone != nil {
+		resp.Diagnostics.Append(r.waitForVectorCount(ctx, diRes.Status.Host, clone.VecotrCount)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Index cloned",
+			fmt.Sprintf("Index %q was created from collection %q, a snapshot of index %q, and holds all %d of its vectors. "+
+				"Index %q is deleted once the replaced resource is destroyed. The collection is not managed by Terraform.",
+				name, clone.Name, predecessor, clone.VecotrCount, predecessor),
+		)
+	}
+
+	if plan.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(r.syncDeletionProtection(ctx, name, diRes, true)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.Host = types.StringValue(diRes.Status.Host)
 
 	// Save data into Terraform state
 	diags = resp.State.Set(ctx, &plan)
@@ -201,6 +899,16 @@ func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	if !state.podBased() {
+		resp.Diagnostics.Append(r.readIndexModel(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
 	// Get fresh state from Pinecone
 	// Generate API request body from plan
 	name := state.Name.ValueString()
@@ -220,8 +928,19 @@ func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.Name = types.StringValue(response.Database.Name)
 	state.Dimension = types.Int64Value(response.Database.Dimension)
 	state.Metric = types.StringValue(response.Database.Metric)
-	state.Replicas = types.Int64Value(response.Database.Replicas)
-	state.Pods = types.Int64Value(response.Database.Pods)
+	state.setPods(response)
+	state.Host = types.StringValue(response.Status.Host)
+	state.EstimatedMonthlyCost = r.estimatedMonthlyCost(ctx, state.PodType, state.Pods)
+	// otherwise deletion protection is only enforced by the provider
+	if response.Database.DeletionProtection != "" {
+		state.DeletionProtection = types.BoolValue(response.Database.DeletionProtection == "enabled")
+	}
+	// otherwise tags are only kept in state
+	if response.Database.Tags != nil {
+		tagsAll, diags := types.MapValueFrom(ctx, types.StringType, response.Database.Tags)
+		resp.Diagnostics.Append(diags...)
+		state.TagsAll = tagsAll
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -239,19 +958,69 @@ func (r *indexResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	var state indexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	indexName := plan.Name.ValueString()
 
-	confIdxResp, err := r.client.ConfigureIndex(indexName, &services.ConfigureIndexRequest{})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to update index",
-			fmt.Sprintf("Failed to update index: %s", err),
-		)
+	if !plan.podBased() {
+		resp.Diagnostics.Append(r.updateIndexModel(ctx, plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		return
 	}
 
-	// log the response
-	tflog.Info(ctx, "ConfigureIndex OK", map[string]any{"response": *confIdxResp})
+	// only send what changed
+	configureIndexRequest := &services.ConfigureIndexRequest{}
+	if !plan.Replicas.Equal(state.Replicas) {
+		configureIndexRequest.Replicas = plan.Replicas.ValueInt64()
+	}
+	if !plan.TagsAll.Equal(state.TagsAll) {
+		oldTags, diags := tagsMap(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := tagsMap(ctx, plan.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		configureIndexRequest.Tags = tagChanges(oldTags, newTags)
+	}
+
+	if configureIndexRequest.Replicas != 0 || len(configureIndexRequest.Tags) != 0 {
+		confIdxResp, err := r.client.ConfigureIndex(indexName, configureIndexRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to update index",
+				fmt.Sprintf("Failed to update index: %s", err),
+			)
+			return
+		}
+
+		// log the response
+		tflog.Info(ctx, "ConfigureIndex OK", map[string]any{"response": *confIdxResp})
+	}
+
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		diRes, err := r.client.DescribeIndex(indexName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to describe index",
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(r.syncDeletionProtection(ctx, indexName, diRes, plan.DeletionProtection.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -271,6 +1040,34 @@ func (r *indexResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_protection"),
+			"Index is protected from deletion",
+			fmt.Sprintf("Index %q has deletion_protection enabled, so it was not deleted. "+
+				"To delete it, set deletion_protection = false and apply, then destroy or replace the index.", state.Name.ValueString()),
+		)
+		return
+	}
+
+	if state.SnapshotOnDestroy.ValueBool() {
+		collection, diags := r.snapshot(ctx, state.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Index snapshot created",
+			fmt.Sprintf("Index %q was snapshot into collection %q before being deleted. The collection is not managed by Terraform.", state.Name.ValueString(), collection.Name),
+		)
+	}
+
+	if !state.podBased() {
+		resp.Diagnostics.Append(r.deleteIndexModel(ctx, state.Name.ValueString())...)
+		return
+	}
+
 	delIdxResp, err := r.client.DeleteIndex(state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -285,12 +1082,348 @@ func (r *indexResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 }
 
+// snapshotName returns the name of the collection an index is snapshot into,
+// ex. my-index-20231004120000. The index name is shortened so that the
+// result stays within the 45 character limit.
+func snapshotName(indexName string, now time.Time) string {
+	timestamp := now.UTC().Format("20060102150405")
+	if max := 45 - len(timestamp) - 1; len(indexName) > max {
+		indexName = strings.TrimRight(indexName[:max], "-")
+	}
+	return fmt.Sprintf("%s-%s", indexName, timestamp)
+}
+
+// generatedIndexName returns the name of an index created from name_prefix,
+// ex. my-index-20231004120000.
+func generatedIndexName(prefix string, now time.Time) string {
+	return fmt.Sprintf("%s-%s", prefix, now.UTC().Format("20060102150405"))
+}
+
+// findPredecessor returns the index that an index created from name_prefix
+// replaces, or "" if there is none. More than one candidate is an error,
+// since the vectors to copy would be ambiguous.
+func (r *indexResource) findPredecessor(prefix string, name string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	indexes, err := r.client.ListIndexes()
+	if err != nil {
+		diags.AddError(
+			"Failed to list indexes",
+			fmt.Sprintf("Failed to list indexes: %s", err),
+		)
+		return "", diags
+	}
+
+	generated := regexp.MustCompile("^" + regexp.QuoteMeta(prefix) + `-\d{14}$`)
+	var candidates []string
+	for _, index := range indexes {
+		if index != name && generated.MatchString(index) {
+			candidates = append(candidates, index)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", diags
+	case 1:
+		return candidates[0], diags
+	default:
+		diags.AddAttributeError(
+			path.Root("clone_on_replace"),
+			"Ambiguous index to clone",
+			fmt.Sprintf("Found %d indexes named with the prefix %q, expected at most one: %s. "+
+				"Delete the indexes that are no longer in use, or set clone_on_replace = false.", len(candidates), prefix, strings.Join(candidates, ", ")),
+		)
+		return "", diags
+	}
+}
+
+// podsChangeShards requires replacement when the planned pods change the
+// number of shards, ie. pods divided by replicas, which cannot be changed in
+// place. Changing the pods and replicas together only adds or removes replicas.
+func podsChangeShards(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	var replicas, shards types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replicas"), &replicas)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("shards"), &shards)...)
+	if resp.Diagnostics.HasError() || req.PlanValue.IsUnknown() || replicas.IsUnknown() || replicas.ValueInt64() <= 0 || shards.IsNull() {
+		return
+	}
+
+	resp.RequiresReplace = req.PlanValue.ValueInt64()/replicas.ValueInt64() != shards.ValueInt64()
+}
+
+// validateServerless rejects the pod attributes of a serverless index,
+// described by index.
+func (m *indexResourceModel) validateServerless(index string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, attribute := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"pods", m.Pods},
+		{"replicas", m.Replicas},
+		{"pod_type", m.PodType},
+	} {
+		if !attribute.value.IsNull() {
+			diags.AddAttributeError(
+				path.Root(attribute.name),
+				"Conflicting pod configuration",
+				fmt.Sprintf("%s is serverless, so %s cannot be set.", index, attribute.name),
+			)
+		}
+	}
+	return diags
+}
+
+// setPods copies the pod attributes reported by describe_index. Serverless
+// indexes, ex. restored from a backup or with embed, have none.
+func (m *indexResourceModel) setPods(index *services.DescribeIndexResponse) {
+	if index.Database.Pods == 0 {
+		m.nullPods()
+		return
+	}
+
+	m.Replicas = types.Int64Value(index.Database.Replicas)
+	m.Pods = types.Int64Value(index.Database.Pods)
+	m.Shards = types.Int64Value(index.Database.Shards)
+	if index.Database.PodType != "" {
+		m.PodType = types.StringValue(index.Database.PodType)
+	}
+}
+
+// nullPods clears the pod attributes of a serverless index.
+func (m *indexResourceModel) nullPods() {
+	m.Replicas = types.Int64Null()
+	m.Pods = types.Int64Null()
+	m.Shards = types.Int64Null()
+	m.PodType = types.StringNull()
+}
+
+// setCreated saves the index to state as soon as it is created, with the
+// attributes that are only known once it is Ready set to null.
+func (m indexResourceModel) setCreated(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	if m.Host.IsUnknown() {
+		m.Host = types.StringNull()
+	}
+	if m.Dimension.IsUnknown() {
+		m.Dimension = types.Int64Null()
+	}
+	if m.Metric.IsUnknown() {
+		m.Metric = types.StringNull()
+	}
+	if m.Shards.IsUnknown() {
+		m.Shards = types.Int64Null()
+	}
+	if m.EstimatedMonthlyCost.IsUnknown() {
+		m.EstimatedMonthlyCost = types.Float64Null()
+	}
+	return state.Set(ctx, &m)
+}
+
+// podBased reports whether the index has pods. Indexes restored from a
+// backup or bound to an embed model are serverless.
+func (m *indexResourceModel) podBased() bool {
+	return m.SourceBackup.IsNull() && m.Embed.IsNull()
+}
+
+// metadataConfig converts metadata_config into the create_index request body.
+func (m *indexResourceModel) metadataConfig(ctx context.Context) (*map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if m.MetadataConfig.IsNull() || m.MetadataConfig.IsUnknown() {
+		return nil, diags
+	}
+
+	var config metadataConfigModel
+	diags.Append(m.MetadataConfig.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+
+	var indexed []string
+	diags.Append(config.Indexed.ElementsAs(ctx, &indexed, false)...)
+
+	return &map[string]interface{}{"indexed": indexed}, diags
+}
+
+// snapshot creates a collection from the index and waits for it to be Ready.
+func (r *indexResource) snapshot(ctx context.Context, indexName string) (*services.DescribeCollectionResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	collectionName := snapshotName(indexName, time.Now())
+
+	response, err := r.client.CreateCollection(services.CreateCollectionBodyParams{
+		Name:   collectionName,
+		Source: indexName,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to snapshot index",
+			fmt.Sprintf("Failed to create collection %q from index %q: %s", collectionName, indexName, err),
+		)
+		return nil, diags
+	}
+
+	// log the response
+	tflog.Info(ctx, "CreateCollection OK", map[string]any{"response": *response, "collection": collectionName})
+
+	return waitForCollection(ctx, r.client, collectionName)
+}
+
+// restore creates the index from a backup, once the backup is Ready, and
+// returns the id of the restore job that populates it.
+func (r *indexResource) restore(ctx context.Context, name string, backupId string, tags map[string]string, deletionProtection bool) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if r.backups == nil {
+		diags.AddError(
+			"Failed to restore backup",
+			"The provider client does not support backups.",
+		)
+		return "", diags
+	}
+
+	_, diags = waitForBackup(ctx, r.backups, backupId)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	request := services.CreateIndexFromBackupRequest{
+		Name:               name,
+		Tags:               tags,
+		DeletionProtection: "disabled",
+	}
+	if deletionProtection {
+		request.DeletionProtection = "enabled"
+	}
+
+	response, err := r.backups.CreateIndexFromBackup(backupId, request)
+	if err != nil {
+		diags.AddError(
+			"Failed to create index",
+			fmt.Sprintf("Failed to create index from backup %q: %s", backupId, err),
+		)
+		return "", diags
+	}
+
+	// log the response
+	tflog.Info(ctx, "CreateIndexFromBackup OK", map[string]any{"response": *response})
+	return response.RestoreJobId, diags
+}
+
+// waitForIndex polls the index until it is Ready.
+func (r *indexResource) waitForIndex(ctx context.Context, name string) (*services.DescribeIndexResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		index, err := r.client.DescribeIndex(name)
+		if err != nil {
+			diags.AddError(
+				"Failed to poll index",
+				fmt.Sprintf("Failed to describe index: %s", err),
+			)
+			return nil, diags
+		}
+
+		if index.Status.State == "Ready" || index.Status.Ready {
+			return index, diags
+		}
+
+		tflog.Debug(ctx, "Waiting for index", map[string]any{"index": name, "state": index.Status.State})
+
+		select {
+		case <-ticker.C: // keep polling
+		case <-ctx.Done():
+			diags.AddError(
+				"Failed to poll index",
+				fmt.Sprintf("Stopped waiting for index %q to be Ready: %s", name, ctx.Err()),
+			)
+			return nil, diags
+		}
+	}
+}
+
+// waitForVectorCount polls the index stats until the index holds at least want
+// vectors, ie. a clone has caught up with its source collection. Writes to the
+// clone may already have added to the count, so it is not matched exactly.
+func (r *indexResource) waitForVectorCount(ctx context.Context, host string, want int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, vectorCountTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		stats, err := r.dataPlane.DescribeIndexStats(host, services.DescribeIndexStatsRequest{})
+		if err != nil {
+			diags.AddError(
+				"Failed to poll index stats",
+				fmt.Sprintf("Failed to describe index stats: %s", err),
+			)
+			return diags
+		}
+
+		if stats.TotalVectorCount >= want {
+			return diags
+		}
+
+		tflog.Debug(ctx, "Waiting for vectors", map[string]any{"host": host, "count": stats.TotalVectorCount, "want": want})
+
+		select {
+		case <-ticker.C: // keep polling
+		case <-ctx.Done():
+			diags.AddError(
+				"Failed to poll index stats",
+				fmt.Sprintf("Stopped waiting for index %q to hold %d vectors, it holds %d: %s", host, want, stats.TotalVectorCount, ctx.Err()),
+			)
+			return diags
+		}
+	}
+}
+
+// syncDeletionProtection enables or disables deletion protection on the index
+// itself, in environments that report it in describe_index. Elsewhere it is
+// only enforced by Delete.
+func (r *indexResource) syncDeletionProtection(ctx context.Context, name string, index *services.DescribeIndexResponse, enabled bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current := index.Database.DeletionProtection
+	if current == "" {
+		tflog.Debug(ctx, "Deletion protection is not supported by this environment, enforcing it in the provider only")
+		return diags
+	}
+
+	desired := "disabled"
+	if enabled {
+		desired = "enabled"
+	}
+	if current == desired {
+		return diags
+	}
+
+	_, err := r.client.ConfigureIndex(name, &services.ConfigureIndexRequest{
+		DeletionProtection: desired,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to configure deletion protection",
+			fmt.Sprintf("Failed to set deletion protection to %s: %s", desired, err),
+		)
+		return diags
+	}
+
+	tflog.Info(ctx, "Configured deletion protection", map[string]any{"deletion_protection": desired})
+	return diags
+}
+
 func (r *indexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "indexResource.ImportState", map[string]any{"req": req, "resp": resp})
 
 	// fetch a fresh index from pinecone
-	// req.ID appears to be our only way to get the index name
-	indexName := req.ID
+	// accept both the index name and the env/name id
+	indexName := indexNameFromId(req.ID)
 	// note that what gets fetched from pinecone, based on purely
 	// the index name, may differ from the rest of whatever is
 	// specified in the resource stanza in HCL
@@ -307,16 +1440,24 @@ func (r *indexResource) ImportState(ctx context.Context, req resource.ImportStat
 
 	// TODO(kevinwang) wait for the index to be in a ready state
 	state := indexResourceModel{}
+	state.Id = types.StringValue(fmt.Sprintf("%s/%s", r.client.Environment(), response.Database.Name))
 	state.Dimension = types.Int64Value(response.Database.Dimension)
 	state.Metric = types.StringValue(response.Database.Metric)
-	state.Replicas = types.Int64Value(response.Database.Replicas)
-	state.Pods = types.Int64Value(response.Database.Pods)
+	state.setPods(response)
 	state.Name = types.StringValue(response.Database.Name)
+	state.Host = types.StringValue(response.Status.Host)
+	state.EstimatedMonthlyCost = r.estimatedMonthlyCost(ctx, state.PodType, state.Pods)
+	state.MetadataConfig = types.ObjectNull(map[string]attr.Type{"indexed": types.ListType{ElemType: types.StringType}})
+	state.Embed = types.ObjectNull(embedAttribute().GetType().(types.ObjectType).AttrTypes)
+	state.DeletionProtection = types.BoolValue(response.Database.DeletionProtection == "enabled")
+	state.SnapshotOnDestroy = types.BoolValue(false)
+	state.CloneOnReplace = types.BoolValue(false)
+	state.Tags = types.MapNull(types.StringType)
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType, mergeTags(nil, response.Database.Tags))
+	resp.Diagnostics.Append(diags...)
+	state.TagsAll = tagsAll
 
 	// Save data into Terraform state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
-
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
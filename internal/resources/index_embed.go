@@ -0,0 +1,207 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// embedModel maps the embed nested attribute of pinecone_index.
+type embedModel struct {
+	Model           types.String `tfsdk:"model"`
+	Cloud           types.String `tfsdk:"cloud"`
+	Region          types.String `tfsdk:"region"`
+	FieldMap        types.Map    `tfsdk:"field_map"`
+	ReadParameters  types.Map    `tfsdk:"read_parameters"`
+	WriteParameters types.Map    `tfsdk:"write_parameters"`
+}
+
+// embedAttribute returns the embed attribute of pinecone_index. Adding or
+// removing it replaces the index; the field map and parameters are updated
+// in place.
+func embedAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Binds the index to a hosted embedding model, so that records are embedded by Pinecone. The index is created as a serverless index, and its dimension and metric are taken from the model. Conflicts with dimension, source_collection, source_backup, clone_on_replace, pods, replicas and pod_type.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The hosted embedding model, ex. multilingual-e5-large. See the pinecone_models data source. Changing it replaces the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud": schema.StringAttribute{
+				Description: "The cloud the serverless index is created in. One of aws, gcp or azure. Defaults to aws.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("aws"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("aws", "gcp", "azure"),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The region the serverless index is created in. Defaults to us-east-1.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("us-east-1"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_map": schema.MapAttribute{
+				Description: "Maps the model's input field to the record field that is embedded, ex. { text = \"chunk_text\" }.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"read_parameters": schema.MapAttribute{
+				Description: "Parameters passed to the model when embedding queries, ex. { input_type = \"query\", truncate = \"END\" }.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"write_parameters": schema.MapAttribute{
+				Description: "Parameters passed to the model when embedding records, ex. { input_type = \"passage\", truncate = \"END\" }.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplaceIf(
+				func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+				},
+				"Adding or removing embed replaces the index.",
+				"Adding or removing `embed` replaces the index.",
+			),
+		},
+	}
+}
+
+// indexEmbed converts embed into the request body of create_for_model and
+// configure_index.
+func (m *indexResourceModel) indexEmbed(ctx context.Context) (*embedModel, services.IndexEmbed, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var embed embedModel
+	diags.Append(m.Embed.As(ctx, &embed, basetypes.ObjectAsOptions{})...)
+
+	request := services.IndexEmbed{
+		Model: embed.Model.ValueString(),
+	}
+	// otherwise the model's default metric is used
+	if !m.Metric.IsUnknown() {
+		request.Metric = m.Metric.ValueString()
+	}
+	diags.Append(embed.FieldMap.ElementsAs(ctx, &request.FieldMap, false)...)
+	request.ReadParameters, diags = embedParameters(ctx, embed.ReadParameters, diags)
+	request.WriteParameters, diags = embedParameters(ctx, embed.WriteParameters, diags)
+
+	return &embed, request, diags
+}
+
+// embedParameters converts read_parameters or write_parameters.
+func embedParameters(ctx context.Context, value types.Map, diags diag.Diagnostics) (map[string]interface{}, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	var parameters map[string]string
+	diags.Append(value.ElementsAs(ctx, &parameters, false)...)
+
+	converted := map[string]interface{}{}
+	for k, v := range parameters {
+		converted[k] = v
+	}
+	return converted, diags
+}
+
+// createForModel creates the index with integrated embedding, and sets the
+// dimension and metric of the model in the plan.
+func (r *indexResource) createForModel(ctx context.Context, plan *indexResourceModel, tags map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.embed == nil {
+		diags.AddError(
+			"Failed to create index",
+			"The provider client does not support integrated embedding.",
+		)
+		return diags
+	}
+
+	embed, request, diags := plan.indexEmbed(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	deletionProtection := "disabled"
+	if plan.DeletionProtection.ValueBool() {
+		deletionProtection = "enabled"
+	}
+
+	index, err := r.embed.CreateIndexForModel(services.CreateIndexForModelRequest{
+		Name:               plan.Name.ValueString(),
+		Cloud:              embed.Cloud.ValueString(),
+		Region:             embed.Region.ValueString(),
+		Embed:              request,
+		DeletionProtection: deletionProtection,
+		Tags:               tags,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to create index",
+			fmt.Sprintf("Failed to create index for model %q: %s", request.Model, err),
+		)
+		return diags
+	}
+
+	// log the response
+	tflog.Info(ctx, "CreateIndexForModel OK", map[string]any{"response": *index})
+
+	plan.Dimension = types.Int64Value(index.Dimension)
+	plan.Metric = types.StringValue(index.Metric)
+	return diags
+}
+
+// setEmbed refreshes the model and field map of embed from the described
+// index. The parameters are kept from the state, since the service may report
+// defaults that were not configured.
+func (m *indexResourceModel) setEmbed(ctx context.Context, index *services.IndexModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.Embed.IsNull() || m.Embed.IsUnknown() {
+		return diags
+	}
+
+	if index.Embed == nil {
+		m.Embed = types.ObjectNull(m.Embed.AttributeTypes(ctx))
+		return diags
+	}
+
+	attributes := m.Embed.Attributes()
+	fieldMap, d := types.MapValueFrom(ctx, types.StringType, index.Embed.FieldMap)
+	diags.Append(d...)
+	attributes["model"] = types.StringValue(index.Embed.Model)
+	attributes["field_map"] = fieldMap
+
+	embed, d := types.ObjectValue(m.Embed.AttributeTypes(ctx), map[string]attr.Value(attributes))
+	diags.Append(d...)
+	m.Embed = embed
+	return diags
+}
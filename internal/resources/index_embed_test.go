@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeEmbedPlane creates indexes for a model with a fixed dimension in the
+// fake controller, and records the requests.
+type fakeEmbedPlane struct {
+	controlPlane *fakeControlPlane
+	created      []services.CreateIndexForModelRequest
+}
+
+func (f *fakeEmbedPlane) CreateIndexForModel(data services.CreateIndexForModelRequest) (*services.IndexModel, error) {
+	f.created = append(f.created, data)
+	f.controlPlane.addModel(&services.IndexModel{Name: data.Name, Dimension: 1024, Metric: "cosine", Embed: &data.Embed})
+	return &services.IndexModel{Name: data.Name, Dimension: 1024, Metric: "cosine", Embed: &data.Embed}, nil
+}
+
+// embedValue builds an embed value with the default cloud and region.
+func embedValue(t *testing.T, model string, fieldMap map[string]string) tftypes.Value {
+	t.Helper()
+
+	objectType := embedAttribute().GetType().TerraformType(context.Background()).(tftypes.Object)
+	fields := map[string]tftypes.Value{}
+	for k, v := range fieldMap {
+		fields[k] = tftypes.NewValue(tftypes.String, v)
+	}
+
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"model":            tftypes.NewValue(tftypes.String, model),
+		"cloud":            tftypes.NewValue(tftypes.String, "aws"),
+		"region":           tftypes.NewValue(tftypes.String, "us-east-1"),
+		"field_map":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, fields),
+		"read_parameters":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"write_parameters": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+	})
+}
+
+func TestIndexCreateForModel(t *testing.T) {
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = 10 * time.Second }()
+
+	controlPlane := &fakeControlPlane{indexes: map[string]*services.DescribeIndexResponse{}}
+	embed := &fakeEmbedPlane{controlPlane: controlPlane}
+	r := &indexResource{client: controlPlane, embed: embed, serverless: controlPlane}
+
+	plan := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":                tftypes.NewValue(tftypes.String, "movies"),
+		"dimension":           tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"metric":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"embed":               embedValue(t, "multilingual-e5-large", map[string]string{"text": "chunk_text"}),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(controlPlane.calls) != 0 {
+		t.Errorf("expected no CreateIndex call, got %v", controlPlane.calls)
+	}
+	if len(embed.created) != 1 {
+		t.Fatalf("expected one create_for_model request, got %+v", embed.created)
+	}
+	request := embed.created[0]
+	if request.Cloud != "aws" || request.Region != "us-east-1" || request.Embed.FieldMap["text"] != "chunk_text" || request.Embed.Metric != "" {
+		t.Errorf("unexpected request %+v", request)
+	}
+
+	var created indexResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &created)...)
+	if created.Dimension.ValueInt64() != 1024 || created.Metric.ValueString() != "cosine" {
+		t.Errorf("expected the dimension and metric of the model, got %d %q", created.Dimension.ValueInt64(), created.Metric.ValueString())
+	}
+	if created.Host.ValueString() != "movies.svc.test-env.pinecone.io" {
+		t.Errorf("unexpected host %q", created.Host.ValueString())
+	}
+	// indexes with embed are serverless, so the controller does not know them
+	if len(controlPlane.indexes) != 0 {
+		t.Errorf("expected no controller index, got %v", controlPlane.indexes)
+	}
+}
+
+func TestIndexUpdateEmbedFieldMap(t *testing.T) {
+	controlPlane := &fakeControlPlane{}
+	controlPlane.addModel(&services.IndexModel{Name: "movies"})
+	r := &indexResource{client: controlPlane, serverless: controlPlane}
+
+	attributes := func(field string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"name":                tftypes.NewValue(tftypes.String, "movies"),
+			"metric":              tftypes.NewValue(tftypes.String, "cosine"),
+			"embed":               embedValue(t, "multilingual-e5-large", map[string]string{"text": field}),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+		}
+	}
+	state := testState(t, NewIndexResource(), attributes("chunk_text"))
+	plan := testState(t, NewIndexResource(), attributes("summary"))
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State: state,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(controlPlane.calls) != 1 || len(controlPlane.configuredModels) != 1 {
+		t.Fatalf("expected a single ConfigureIndexModel call, got %v", controlPlane.calls)
+	}
+	// the model and metric cannot be changed, so they are not sent
+	embed := controlPlane.configuredModels[0].Embed
+	if embed == nil || embed.FieldMap["text"] != "summary" || embed.Model != "" || embed.Metric != "" {
+		t.Errorf("unexpected configure request %+v", controlPlane.configuredModels[0])
+	}
+}
+
+func TestIndexModifyPlanEmbed(t *testing.T) {
+	ctx := context.Background()
+	r := &indexResource{}
+
+	embed := embedValue(t, "multilingual-e5-large", map[string]string{"text": "chunk_text"})
+	config := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, "movies"),
+		"embed": embed,
+	})
+	// the defaults of metric and the pod attributes
+	planned := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":      tftypes.NewValue(tftypes.String, "movies"),
+		"embed":     embed,
+		"dimension": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"metric":    tftypes.NewValue(tftypes.String, "cosine"),
+		"pods":      tftypes.NewValue(tftypes.Number, 1),
+		"replicas":  tftypes.NewValue(tftypes.Number, 1),
+		"pod_type":  tftypes.NewValue(tftypes.String, "p1.x1"),
+	})
+	plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		Plan:   plan,
+		State:  tfsdk.State{Schema: planned.Schema, Raw: tftypes.NewValue(planned.Raw.Type(), nil)},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got indexResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+	if !got.Metric.IsUnknown() {
+		t.Errorf("expected the metric of the model to be unknown until created, got %s", got.Metric)
+	}
+	if !got.Pods.IsNull() || !got.Replicas.IsNull() || !got.Shards.IsNull() || !got.PodType.IsNull() || !got.EstimatedMonthlyCost.IsNull() {
+		t.Errorf("expected no pods and no estimated cost, got %+v", got)
+	}
+}
+
+func TestIndexReadServerlessHasNoPods(t *testing.T) {
+	controlPlane := &fakeControlPlane{}
+	controlPlane.addModel(&services.IndexModel{Name: "movies", Dimension: 1024, Metric: "cosine"})
+	r := &indexResource{client: controlPlane, serverless: controlPlane}
+
+	// the pod defaults were kept in the state of indexes created before they
+	// were planned as null
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":     tftypes.NewValue(tftypes.String, "movies"),
+		"embed":    embedValue(t, "multilingual-e5-large", map[string]string{"text": "chunk_text"}),
+		"pods":     tftypes.NewValue(tftypes.Number, 1),
+		"replicas": tftypes.NewValue(tftypes.Number, 1),
+		"shards":   tftypes.NewValue(tftypes.Number, 1),
+		"pod_type": tftypes.NewValue(tftypes.String, "p1.x1"),
+	})
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var read indexResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &read)...)
+	if !read.Pods.IsNull() || !read.Replicas.IsNull() || !read.Shards.IsNull() || !read.PodType.IsNull() || !read.EstimatedMonthlyCost.IsNull() {
+		t.Errorf("expected no pods and no estimated cost, got %+v", read)
+	}
+}
+
+func TestIndexReadEmbed(t *testing.T) {
+	controlPlane := &fakeControlPlane{}
+	controlPlane.addModel(&services.IndexModel{Name: "movies", Dimension: 1024, Metric: "cosine", Embed: &services.IndexEmbed{
+		Model:          "multilingual-e5-large",
+		FieldMap:       map[string]string{"text": "summary"},
+		ReadParameters: map[string]interface{}{"input_type": "query"},
+	}})
+	r := &indexResource{client: controlPlane, serverless: controlPlane}
+
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, "movies"),
+		"embed": embedValue(t, "multilingual-e5-large", map[string]string{"text": "chunk_text"}),
+	})
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// the field map is refreshed, the parameters the service defaults are not
+	var read indexResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &read)...)
+	attributes := read.Embed.Attributes()
+	if attributes["field_map"].String() != `{"text":"summary"}` || !attributes["read_parameters"].IsNull() {
+		t.Errorf("unexpected embed %s", read.Embed)
+	}
+	if read.Host.ValueString() != "movies.svc.test-env.pinecone.io" {
+		t.Errorf("unexpected host %q", read.Host.ValueString())
+	}
+}
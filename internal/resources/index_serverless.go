@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Serverless indexes, ie. restored from a backup or with embed, are managed by
+// the global control plane at api.pinecone.io rather than by the controller
+// of the environment.
+
+// serverlessPlane returns the client for serverless indexes, or an error
+// diagnostic if the provider client does not support them.
+func (r *indexResource) serverlessPlane(summary string) (services.ServerlessPlane, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if r.serverless == nil {
+		diags.AddError(
+			summary,
+			"The provider client does not support serverless indexes.",
+		)
+	}
+	return r.serverless, diags
+}
+
+// waitForIndexModel polls the serverless index until it is Ready.
+func (r *indexResource) waitForIndexModel(ctx context.Context, name string) (*services.IndexModel, diag.Diagnostics) {
+	serverless, diags := r.serverlessPlane("Failed to poll index")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		index, err := serverless.DescribeIndexModel(name)
+		if err != nil {
+			diags.AddError(
+				"Failed to poll index",
+				fmt.Sprintf("Failed to describe index: %s", err),
+			)
+			return nil, diags
+		}
+
+		if index.Status.State == "Ready" || index.Status.Ready {
+			return index, diags
+		}
+
+		tflog.Debug(ctx, "Waiting for index", map[string]any{"index": name, "state": index.Status.State})
+
+		select {
+		case <-ticker.C: // keep polling
+		case <-ctx.Done():
+			diags.AddError(
+				"Failed to poll index",
+				fmt.Sprintf("Stopped waiting for index %q to be Ready: %s", name, ctx.Err()),
+			)
+			return nil, diags
+		}
+	}
+}
+
+// readIndexModel refreshes the state of a serverless index.
+func (r *indexResource) readIndexModel(ctx context.Context, state *indexResourceModel) diag.Diagnostics {
+	serverless, diags := r.serverlessPlane("Failed to describe index")
+	if diags.HasError() {
+		return diags
+	}
+
+	index, err := serverless.DescribeIndexModel(state.Name.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Failed to describe index",
+			err.Error(),
+		)
+		return diags
+	}
+
+	// log the response
+	tflog.Info(ctx, "DescribeIndexModel OK", map[string]any{"response": *index})
+
+	state.Name = types.StringValue(index.Name)
+	state.Dimension = types.Int64Value(index.Dimension)
+	state.Metric = types.StringValue(index.Metric)
+	state.nullPods()
+	state.Host = types.StringValue(index.Host)
+	state.EstimatedMonthlyCost = types.Float64Null()
+	if index.DeletionProtection != "" {
+		state.DeletionProtection = types.BoolValue(index.DeletionProtection == "enabled")
+	}
+	if index.Tags != nil {
+		tagsAll, d := types.MapValueFrom(ctx, types.StringType, index.Tags)
+		diags.Append(d...)
+		state.TagsAll = tagsAll
+	}
+
+	diags.Append(state.setEmbed(ctx, index)...)
+	return diags
+}
+
+// updateIndexModel sends the changed deletion protection, tags and embedding
+// configuration of a serverless index to configure_index, in a single request.
+func (r *indexResource) updateIndexModel(ctx context.Context, plan indexResourceModel, state indexResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	request := services.ConfigureIndexModelRequest{}
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		request.DeletionProtection = "disabled"
+		if plan.DeletionProtection.ValueBool() {
+			request.DeletionProtection = "enabled"
+		}
+	}
+	if !plan.TagsAll.Equal(state.TagsAll) {
+		oldTags, d := tagsMap(ctx, state.TagsAll)
+		diags.Append(d...)
+		newTags, d := tagsMap(ctx, plan.TagsAll)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		request.Tags = tagChanges(oldTags, newTags)
+	}
+	if !plan.Embed.IsNull() && !plan.Embed.Equal(state.Embed) {
+		_, embed, d := plan.indexEmbed(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		// neither can be changed
+		embed.Model = ""
+		embed.Metric = ""
+		request.Embed = &embed
+	}
+	if request.DeletionProtection == "" && len(request.Tags) == 0 && request.Embed == nil {
+		return diags
+	}
+
+	serverless, diags := r.serverlessPlane("Failed to update index")
+	if diags.HasError() {
+		return diags
+	}
+
+	index, err := serverless.ConfigureIndexModel(plan.Name.ValueString(), request)
+	if err != nil {
+		diags.AddError(
+			"Failed to update index",
+			fmt.Sprintf("Failed to update index: %s", err),
+		)
+		return diags
+	}
+
+	// log the response
+	tflog.Info(ctx, "ConfigureIndexModel OK", map[string]any{"response": *index})
+	return diags
+}
+
+// deleteIndexModel deletes a serverless index.
+func (r *indexResource) deleteIndexModel(ctx context.Context, name string) diag.Diagnostics {
+	serverless, diags := r.serverlessPlane("Failed to delete index")
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := serverless.DeleteIndexModel(name); err != nil {
+		diags.AddError(
+			"Failed to delete index",
+			fmt.Sprintf("Failed to delete index: %s", err),
+		)
+		return diags
+	}
+
+	tflog.Info(ctx, "DeleteIndexModel OK", map[string]any{"index": name})
+	return diags
+}
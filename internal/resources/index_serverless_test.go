@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// addModel adds a Ready serverless index to the global control plane.
+func (f *fakeControlPlane) addModel(index *services.IndexModel) {
+	if f.models == nil {
+		f.models = map[string]*services.IndexModel{}
+	}
+	index.Host = index.Name + ".svc.test-env.pinecone.io"
+	index.Status.Ready = true
+	index.Status.State = "Ready"
+	f.models[index.Name] = index
+}
+
+func (f *fakeControlPlane) DescribeIndexModel(name string) (*services.IndexModel, error) {
+	index, ok := f.models[name]
+	if !ok {
+		return nil, &services.APIError{Operation: "DescribeIndexModel", StatusCode: 404, Message: "not found"}
+	}
+	return index, nil
+}
+
+func (f *fakeControlPlane) ConfigureIndexModel(name string, data services.ConfigureIndexModelRequest) (*services.IndexModel, error) {
+	f.calls = append(f.calls, "ConfigureIndexModel "+name)
+	f.configuredModels = append(f.configuredModels, data)
+	return f.models[name], nil
+}
+
+func (f *fakeControlPlane) DeleteIndexModel(name string) error {
+	f.calls = append(f.calls, "DeleteIndexModel "+name)
+	f.deleted = append(f.deleted, name)
+	delete(f.models, name)
+	return nil
+}
+
+func TestIndexReadRestored(t *testing.T) {
+	controlPlane := &fakeControlPlane{}
+	controlPlane.addModel(&services.IndexModel{Name: "movies-restored", Dimension: 1536, Metric: "dotproduct", DeletionProtection: "enabled", Tags: map[string]string{"team": "search"}})
+	r := &indexResource{client: controlPlane, serverless: controlPlane}
+
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":                tftypes.NewValue(tftypes.String, "movies-restored"),
+		"source_backup":       tftypes.NewValue(tftypes.String, "b1"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var read indexResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &read)...)
+	if read.Host.ValueString() != "movies-restored.svc.test-env.pinecone.io" || read.Dimension.ValueInt64() != 1536 || read.Metric.ValueString() != "dotproduct" {
+		t.Errorf("unexpected index %+v", read)
+	}
+	if !read.DeletionProtection.ValueBool() {
+		t.Errorf("expected deletion protection to be refreshed")
+	}
+	if read.TagsAll.Elements()["team"].String() != `"search"` {
+		t.Errorf("unexpected tags_all %s", read.TagsAll)
+	}
+}
+
+func TestIndexUpdateRestored(t *testing.T) {
+	controlPlane := &fakeControlPlane{}
+	controlPlane.addModel(&services.IndexModel{Name: "movies-restored"})
+	r := &indexResource{client: controlPlane, serverless: controlPlane}
+
+	attributes := func(deletionProtection bool, team string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"name":                tftypes.NewValue(tftypes.String, "movies-restored"),
+			"source_backup":       tftypes.NewValue(tftypes.String, "b1"),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, deletionProtection),
+			"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"team": tftypes.NewValue(tftypes.String, team),
+			}),
+		}
+	}
+	state := testState(t, NewIndexResource(), attributes(false, "search"))
+	plan := testState(t, NewIndexResource(), attributes(true, "ranking"))
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State: state,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(controlPlane.calls) != 1 || len(controlPlane.configuredModels) != 1 {
+		t.Fatalf("expected a single ConfigureIndexModel call, got %v", controlPlane.calls)
+	}
+	request := controlPlane.configuredModels[0]
+	if request.DeletionProtection != "enabled" || request.Tags["team"] != "ranking" {
+		t.Errorf("unexpected request %+v", request)
+	}
+}
+
+func TestIndexDeleteRestored(t *testing.T) {
+	controlPlane := &fakeControlPlane{}
+	controlPlane.addModel(&services.IndexModel{Name: "movies-restored"})
+	r := &indexResource{client: controlPlane, serverless: controlPlane}
+
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":                tftypes.NewValue(tftypes.String, "movies-restored"),
+		"source_backup":       tftypes.NewValue(tftypes.String, "b1"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(controlPlane.calls) != 1 || controlPlane.calls[0] != "DeleteIndexModel movies-restored" {
+		t.Errorf("expected the index to be deleted through the global API, got %v", controlPlane.calls)
+	}
+}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -18,6 +19,13 @@ var (
 		# will use PINECONE_API_KEY
 		# and PINECONE_ENVIRONMENT env vars
 	}`
+	// modelsConfig looks up the dimension of a hosted embedding model, rather
+	// than hard-coding it in every index.
+	modelsConfig = `
+
+data "pinecone_models" "e5" {
+	name = "multilingual-e5-large"
+}`
 	// testAccProtoV6ProviderFactories are used to instantiate a provider during
 	// acceptance testing. The factory function will be invoked for every Terraform
 	// CLI command executed to create a provider server to which the CLI can
@@ -35,8 +43,10 @@ func TestMain(m *testing.M) {
 #######################
 	`)
 
-	// Load environment variables from a .env file
-	if err := godotenv.Load("../../.env"); err != nil {
+	// Load environment variables from a .env file. Without one, the unit
+	// tests still run and the acceptance tests are skipped unless TF_ACC
+	// and the credentials are set in the environment.
+	if err := godotenv.Load("../../.env"); err != nil && !errors.Is(err, os.ErrNotExist) {
 		panic(fmt.Sprintf("Error loading ../../.env file: %v", err))
 	}
 
@@ -59,18 +69,18 @@ func TestAccOrderResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: providerConfig + `
+				Config: providerConfig + modelsConfig + `
 
 resource "pinecone_index" "test" {
 	name      = "acceptance-test"
-	dimension = 1536
+	dimension = data.pinecone_models.e5.models[0].default_dimension
 	metric    = "cosine"
 	pods      = 1
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify attributes
-					resource.TestCheckResourceAttr("pinecone_index.test", "dimension", "1536"),
+					resource.TestCheckResourceAttrPair("pinecone_index.test", "dimension", "data.pinecone_models.e5", "models.0.default_dimension"),
 					resource.TestCheckResourceAttr("pinecone_index.test", "metric", "cosine"),
 					resource.TestCheckResourceAttr("pinecone_index.test", "name", "acceptance-test"),
 					resource.TestCheckResourceAttr("pinecone_index.test", "pods", "1"),
@@ -93,18 +103,18 @@ resource "pinecone_index" "test" {
 			// Update and Read testing
 			// TODO(kevinwang) - update doesn't work yet on the Pinecone free tier
 			// 			{
-			// 				Config: providerConfig + `
+			// 				Config: providerConfig + modelsConfig + `
 
 			// resource "pinecone_index" "test" {
 			// 	name      = "acceptance-test"
-			// 	dimension = 1536
+			// 	dimension = data.pinecone_models.e5.models[0].default_dimension
 			// 	metric    = "cosine"
 			// 	pods      = 1
 			// }
 			// `,
 			// 				Check: resource.ComposeAggregateTestCheckFunc(
 			// 					// Verify attributes
-			// 					resource.TestCheckResourceAttr("pinecone_index.test", "dimension", "1536"),
+			// 					resource.TestCheckResourceAttrPair("pinecone_index.test", "dimension", "data.pinecone_models.e5", "models.0.default_dimension"),
 			// 					resource.TestCheckResourceAttr("pinecone_index.test", "metric", "cosine"),
 			// 					resource.TestCheckResourceAttr("pinecone_index.test", "name", "acceptance-test"),
 			// 					resource.TestCheckResourceAttr("pinecone_index.test", "pods", "1"),
@@ -0,0 +1,604 @@
+package resources
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// fakeControlPlane records the controller calls made by a resource. Calling
+// a method that is not stubbed panics on the nil embedded interface.
+type fakeControlPlane struct {
+	services.ControlPlane
+	indexes map[string]*services.DescribeIndexResponse
+	deleted []string
+	// statuses returned by successive DescribeCollection calls
+	collectionStatuses []string
+	// vector_count and dimension reported by DescribeCollection
+	collectionVectorCount int64
+	collectionDimension   int64
+	calls                 []string
+	configured            []*services.ConfigureIndexRequest
+	// serverless indexes, described by the global control plane
+	models           map[string]*services.IndexModel
+	configuredModels []services.ConfigureIndexModelRequest
+}
+
+func (f *fakeControlPlane) Environment() string {
+	return "test-env"
+}
+
+func (f *fakeControlPlane) DescribeIndex(name string) (*services.DescribeIndexResponse, error) {
+	index, ok := f.indexes[name]
+	if !ok {
+		return nil, &services.APIError{Operation: "DescribeIndex", StatusCode: 404, Message: "not found"}
+	}
+	return index, nil
+}
+
+func (f *fakeControlPlane) CreateCollection(bodyParams services.CreateCollectionBodyParams) (*string, error) {
+	f.calls = append(f.calls, "CreateCollection "+bodyParams.Source)
+	created := ""
+	return &created, nil
+}
+
+func (f *fakeControlPlane) DescribeCollection(name string) (*services.DescribeCollectionResponse, error) {
+	f.calls = append(f.calls, "DescribeCollection")
+	if len(f.collectionStatuses) == 0 {
+		return nil, &services.APIError{Operation: "DescribeCollection", StatusCode: 404, Message: "not found"}
+	}
+	status := f.collectionStatuses[0]
+	if len(f.collectionStatuses) > 1 {
+		f.collectionStatuses = f.collectionStatuses[1:]
+	}
+	return &services.DescribeCollectionResponse{Name: name, Status: status, VecotrCount: f.collectionVectorCount, Dimension: f.collectionDimension}, nil
+}
+
+func (f *fakeControlPlane) ListIndexes() ([]string, error) {
+	var names []string
+	for name := range f.indexes {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (f *fakeControlPlane) CreateIndex(bodyParams services.CreateIndexBodyParams) (*string, error) {
+	f.calls = append(f.calls, "CreateIndex "+bodyParams.SourceCollection)
+	index := &services.DescribeIndexResponse{}
+	index.Database.Name = bodyParams.Name
+	index.Status.State = "Ready"
+	index.Status.Host = bodyParams.Name + ".svc.test-env.pinecone.io"
+	f.indexes[bodyParams.Name] = index
+	created := ""
+	return &created, nil
+}
+
+// fakeDataPlane reports successive vector counts from DescribeIndexStats.
+type fakeDataPlane struct {
+	services.DataPlane
+	vectorCounts []int64
+}
+
+func (f *fakeDataPlane) DescribeIndexStats(host string, data services.DescribeIndexStatsRequest) (*services.DescribeIndexStatsResponse, error) {
+	count := f.vectorCounts[0]
+	if len(f.vectorCounts) > 1 {
+		f.vectorCounts = f.vectorCounts[1:]
+	}
+	return &services.DescribeIndexStatsResponse{TotalVectorCount: count}, nil
+}
+
+func (f *fakeControlPlane) DeleteIndex(name string) (*string, error) {
+	f.calls = append(f.calls, "DeleteIndex "+name)
+	f.deleted = append(f.deleted, name)
+	delete(f.indexes, name)
+	accepted := ""
+	return &accepted, nil
+}
+
+func (f *fakeControlPlane) DeleteCollection(name string) (*string, error) {
+	f.calls = append(f.calls, "DeleteCollection "+name)
+	accepted := ""
+	return &accepted, nil
+}
+
+func (f *fakeControlPlane) ConfigureIndex(name string, data *services.ConfigureIndexRequest) (*string, error) {
+	f.calls = append(f.calls, "ConfigureIndex "+name)
+	f.configured = append(f.configured, data)
+	accepted := ""
+	return &accepted, nil
+}
+
+// testState builds a plan or state of r with the given attributes set and
+// every other attribute null.
+func testState(t *testing.T, r resource.Resource, attributes map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+func TestIndexDeleteProtected(t *testing.T) {
+	tests := []struct {
+		name        string
+		protected   bool
+		wantDeleted int
+	}{
+		{"protected", true, 0},
+		{"unprotected", false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeControlPlane{indexes: map[string]*services.DescribeIndexResponse{"primary": {}}}
+			r := &indexResource{client: fake}
+
+			resp := &resource.DeleteResponse{}
+			r.Delete(context.Background(), resource.DeleteRequest{
+				State: testState(t, NewIndexResource(), map[string]tftypes.Value{
+					"name":                tftypes.NewValue(tftypes.String, "primary"),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, tt.protected),
+				}),
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.protected {
+				t.Errorf("expected an error: %t, got %v", tt.protected, resp.Diagnostics)
+			}
+			if len(fake.deleted) != tt.wantDeleted {
+				t.Errorf("expected %d DeleteIndex calls, got %d", tt.wantDeleted, len(fake.deleted))
+			}
+		})
+	}
+}
+
+func TestIndexDeleteSnapshotsFirst(t *testing.T) {
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = 10 * time.Second }()
+
+	fake := &fakeControlPlane{
+		indexes:            map[string]*services.DescribeIndexResponse{"primary": {}},
+		collectionStatuses: []string{"Initializing", "Initializing", "Ready"},
+	}
+	r := &indexResource{client: fake}
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{
+		State: testState(t, NewIndexResource(), map[string]tftypes.Value{
+			"name":                tftypes.NewValue(tftypes.String, "primary"),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+			"snapshot_on_destroy": tftypes.NewValue(tftypes.Bool, true),
+		}),
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(resp.Diagnostics.Warnings()) != 1 {
+		t.Errorf("expected a warning naming the snapshot, got %v", resp.Diagnostics)
+	}
+
+	want := []string{"CreateCollection primary", "DescribeCollection", "DescribeCollection", "DescribeCollection", "DeleteIndex primary"}
+	if !reflect.DeepEqual(fake.calls, want) {
+		t.Errorf("expected calls %v, got %v", want, fake.calls)
+	}
+}
+
+func TestSnapshotName(t *testing.T) {
+	now := time.Date(2023, 10, 4, 12, 0, 0, 0, time.UTC)
+
+	if got := snapshotName("primary", now); got != "primary-20231004120000" {
+		t.Errorf("unexpected name %q", got)
+	}
+
+	long := "an-index-name-that-uses-every-one-of-45-chars"
+	if got := snapshotName(long, now); len(got) > 45 || got != "an-index-name-that-uses-every-20231004120000" {
+		t.Errorf("unexpected name %q (%d characters)", got, len(got))
+	}
+}
+
+func TestIndexCreateClonesPredecessor(t *testing.T) {
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = 10 * time.Second }()
+
+	fake := &fakeControlPlane{
+		indexes: map[string]*services.DescribeIndexResponse{
+			"primary-20231004120000": {},
+			"primary-staging":        {},
+		},
+		collectionStatuses:    []string{"Ready"},
+		collectionVectorCount: 10,
+	}
+	dataPlane := &fakeDataPlane{vectorCounts: []int64{0, 4, 10}}
+	r := &indexResource{client: fake, dataPlane: dataPlane}
+
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name_prefix":      tftypes.NewValue(tftypes.String, "primary"),
+		"dimension":        tftypes.NewValue(tftypes.Number, 8),
+		"metric":           tftypes.NewValue(tftypes.String, "cosine"),
+		"replicas":         tftypes.NewValue(tftypes.Number, 1),
+		"pods":             tftypes.NewValue(tftypes.Number, 1),
+		"pod_type":         tftypes.NewValue(tftypes.String, "p1.x2"),
+		"clone_on_replace": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: state.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(resp.Diagnostics.Warnings()) != 1 {
+		t.Errorf("expected a warning naming the clone, got %v", resp.Diagnostics)
+	}
+	if len(dataPlane.vectorCounts) != 1 {
+		t.Errorf("expected to wait for all vectors, %v counts left", dataPlane.vectorCounts)
+	}
+
+	if len(fake.calls) != 3 || fake.calls[0] != "CreateCollection primary-20231004120000" || !strings.HasPrefix(fake.calls[2], "CreateIndex primary-20231004120000-") {
+		t.Errorf("expected the predecessor to be snapshot and cloned, got calls %v", fake.calls)
+	}
+
+	var created indexResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &created)...)
+	if !strings.HasPrefix(created.Name.ValueString(), "primary-") || created.Name.ValueString() == "primary-20231004120000" {
+		t.Errorf("expected a new name generated from the prefix, got %q", created.Name.ValueString())
+	}
+	if created.Host.ValueString() != created.Name.ValueString()+".svc.test-env.pinecone.io" {
+		t.Errorf("unexpected host %q", created.Host.ValueString())
+	}
+	if !created.SourceCollection.IsNull() {
+		t.Errorf("expected source_collection to stay null, got %q", created.SourceCollection.ValueString())
+	}
+}
+
+func TestIndexCreateCloneTimeoutKeepsIndex(t *testing.T) {
+	pollInterval = time.Millisecond
+	vectorCountTimeout = 20 * time.Millisecond
+	defer func() {
+		pollInterval = 10 * time.Second
+		vectorCountTimeout = 30 * time.Minute
+	}()
+
+	fake := &fakeControlPlane{
+		indexes:               map[string]*services.DescribeIndexResponse{"primary-20231004120000": {}},
+		collectionStatuses:    []string{"Ready"},
+		collectionVectorCount: 10,
+	}
+	r := &indexResource{client: fake, dataPlane: &fakeDataPlane{vectorCounts: []int64{4}}}
+
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name_prefix":      tftypes.NewValue(tftypes.String, "primary"),
+		"dimension":        tftypes.NewValue(tftypes.Number, 8),
+		"metric":           tftypes.NewValue(tftypes.String, "cosine"),
+		"replicas":         tftypes.NewValue(tftypes.Number, 1),
+		"pods":             tftypes.NewValue(tftypes.Number, 1),
+		"pod_type":         tftypes.NewValue(tftypes.String, "p1.x2"),
+		"host":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"id":               tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"clone_on_replace": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: state.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected the clone to time out")
+	}
+
+	// the clone exists, so it is kept in state to be tainted
+	var created indexResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &created)...)
+	if !strings.HasPrefix(created.Id.ValueString(), "test-env/primary-") || !created.Host.IsNull() {
+		t.Errorf("expected the index to be saved before waiting, got id %q and host %s", created.Id.ValueString(), created.Host)
+	}
+	if !resp.State.Raw.IsFullyKnown() {
+		t.Errorf("expected no unknown values in state, got %v", resp.State.Raw)
+	}
+}
+
+func TestFindPredecessorAmbiguous(t *testing.T) {
+	fake := &fakeControlPlane{
+		indexes: map[string]*services.DescribeIndexResponse{
+			"primary-20231004120000": {},
+			"primary-20231005120000": {},
+		},
+	}
+	r := &indexResource{client: fake}
+
+	_, diags := r.findPredecessor("primary", "primary-20231006120000")
+	if !diags.HasError() {
+		t.Errorf("expected an error for two candidates")
+	}
+
+	delete(fake.indexes, "primary-20231005120000")
+	predecessor, diags := r.findPredecessor("primary", "primary-20231006120000")
+	if diags.HasError() || predecessor != "primary-20231004120000" {
+		t.Errorf("unexpected predecessor %q: %v", predecessor, diags)
+	}
+}
+
+func TestIndexModifyPlanSourceCollection(t *testing.T) {
+	tests := []struct {
+		name          string
+		dimension     interface{}
+		status        string
+		wantErr       string
+		wantDimension int64
+	}{
+		{"dimension from collection", nil, "Ready", "", 1536},
+		{"matching dimension", int64(1536), "Ready", "", 1536},
+		{"mismatched dimension", int64(768), "Ready", "Dimension does not match source collection", 0},
+		{"collection not ready", int64(1536), "Initializing", "Source collection not Ready", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			fake := &fakeControlPlane{collectionStatuses: []string{tt.status}, collectionDimension: 1536}
+			r := &indexResource{client: fake}
+
+			config := testState(t, NewIndexResource(), map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "primary"),
+				"source_collection": tftypes.NewValue(tftypes.String, "snapshot"),
+				"dimension":         tftypes.NewValue(tftypes.Number, tt.dimension),
+			})
+			dimension := tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+			if tt.dimension != nil {
+				dimension = tftypes.NewValue(tftypes.Number, tt.dimension)
+			}
+			planned := testState(t, NewIndexResource(), map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "primary"),
+				"source_collection": tftypes.NewValue(tftypes.String, "snapshot"),
+				"dimension":         dimension,
+				"pods":              tftypes.NewValue(tftypes.Number, 1),
+				"replicas":          tftypes.NewValue(tftypes.Number, 1),
+			})
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: planned.Schema, Raw: tftypes.NewValue(planned.Raw.Type(), nil)},
+			}, resp)
+
+			if tt.wantErr != "" {
+				if len(resp.Diagnostics.Errors()) != 1 || resp.Diagnostics.Errors()[0].Summary() != tt.wantErr {
+					t.Errorf("expected error %q, got %v", tt.wantErr, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got indexResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+			if got.Dimension.ValueInt64() != tt.wantDimension {
+				t.Errorf("expected dimension %d, got %s", tt.wantDimension, got.Dimension)
+			}
+		})
+	}
+}
+
+func TestIndexModifyPlanCostWarning(t *testing.T) {
+	ctx := context.Background()
+	r := &indexResource{costWarningThreshold: 100}
+
+	state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name":     tftypes.NewValue(tftypes.String, "primary"),
+		"pod_type": tftypes.NewValue(tftypes.String, "p1.x1"),
+		"pods":     tftypes.NewValue(tftypes.Number, 1),
+		"replicas": tftypes.NewValue(tftypes.Number, 1),
+	})
+
+	tests := []struct {
+		name        string
+		pods        int64
+		wantWarning bool
+	}{
+		// 0.096 per pod-hour, ~70 per pod-month
+		{"below threshold", 2, false},
+		{"above threshold", 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planned := testState(t, NewIndexResource(), map[string]tftypes.Value{
+				"name":     tftypes.NewValue(tftypes.String, "primary"),
+				"pod_type": tftypes.NewValue(tftypes.String, "p1.x1"),
+				"pods":     tftypes.NewValue(tftypes.Number, tt.pods),
+				"replicas": tftypes.NewValue(tftypes.Number, 1),
+			})
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if got := len(resp.Diagnostics.Warnings()) == 1; got != tt.wantWarning {
+				t.Errorf("expected a warning: %t, got %v", tt.wantWarning, resp.Diagnostics)
+			}
+
+			var got indexResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+			if want := 0.096 * float64(tt.pods) * 730; math.Abs(got.EstimatedMonthlyCost.ValueFloat64()-want) > 1e-9 {
+				t.Errorf("expected estimated_monthly_cost %v, got %s", want, got.EstimatedMonthlyCost)
+			}
+		})
+	}
+}
+
+func TestIndexUpdateSendsChanges(t *testing.T) {
+	tags := func(value string) tftypes.Value {
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"team": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+	index := func(replicas int64, tagsAll tftypes.Value) tfsdk.State {
+		return testState(t, NewIndexResource(), map[string]tftypes.Value{
+			"name":                tftypes.NewValue(tftypes.String, "primary"),
+			"replicas":            tftypes.NewValue(tftypes.Number, replicas),
+			"pods":                tftypes.NewValue(tftypes.Number, replicas),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+			"tags_all":            tagsAll,
+		})
+	}
+
+	tests := []struct {
+		name         string
+		state        tfsdk.State
+		plan         tfsdk.State
+		wantReplicas int64
+		wantTags     map[string]string
+	}{
+		{"tags only", index(1, tags("search")), index(1, tags("ranking")), 0, map[string]string{"team": "ranking"}},
+		{"replicas", index(1, tags("search")), index(2, tags("search")), 2, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeControlPlane{}
+			r := &indexResource{client: fake}
+
+			resp := &resource.UpdateResponse{State: tt.state}
+			r.Update(context.Background(), resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: tt.plan.Schema, Raw: tt.plan.Raw},
+				State: tt.state,
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if len(fake.configured) != 1 {
+				t.Fatalf("expected one configure_index call, got %d", len(fake.configured))
+			}
+			got := fake.configured[0]
+			if got.Replicas != tt.wantReplicas || len(got.Tags) != len(tt.wantTags) || got.Tags["team"] != tt.wantTags["team"] {
+				t.Errorf("unexpected configure_index request %+v", got)
+			}
+		})
+	}
+}
+
+func TestPodsChangeShards(t *testing.T) {
+	tests := []struct {
+		name                   string
+		pods, replicas, shards int64
+		wantReplace            bool
+	}{
+		{"more replicas", 4, 2, 2, false},
+		{"more shards", 4, 1, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testState(t, NewIndexResource(), map[string]tftypes.Value{
+				"shards": tftypes.NewValue(tftypes.Number, tt.shards),
+			})
+			plan := testState(t, NewIndexResource(), map[string]tftypes.Value{
+				"pods":     tftypes.NewValue(tftypes.Number, tt.pods),
+				"replicas": tftypes.NewValue(tftypes.Number, tt.replicas),
+			})
+
+			resp := &int64planmodifier.RequiresReplaceIfFuncResponse{}
+			podsChangeShards(context.Background(), planmodifier.Int64Request{
+				Plan:      tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State:     state,
+				PlanValue: types.Int64Value(tt.pods),
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.RequiresReplace != tt.wantReplace {
+				t.Errorf("expected replace %v, got %v", tt.wantReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestWaitForVectorCount(t *testing.T) {
+	pollInterval = time.Millisecond
+	vectorCountTimeout = 50 * time.Millisecond
+	defer func() {
+		pollInterval = 10 * time.Second
+		vectorCountTimeout = 30 * time.Minute
+	}()
+
+	tests := []struct {
+		name         string
+		vectorCounts []int64
+		wantErr      bool
+	}{
+		{"caught up", []int64{4, 10}, false},
+		{"written to before observed", []int64{4, 12}, false},
+		{"never caught up", []int64{4}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &indexResource{dataPlane: &fakeDataPlane{vectorCounts: tt.vectorCounts}}
+
+			diags := r.waitForVectorCount(context.Background(), "clone.svc.test-env.pinecone.io", 10)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestIndexImportStateId(t *testing.T) {
+	index := &services.DescribeIndexResponse{}
+	index.Database.Name = "movies"
+	fake := &fakeControlPlane{indexes: map[string]*services.DescribeIndexResponse{"movies": index}}
+	r := &indexResource{client: fake}
+
+	// the id of an imported index must be parseable by parse_index_id
+	for _, id := range []string{"movies", "test-env/movies"} {
+		t.Run(id, func(t *testing.T) {
+			state := testState(t, NewIndexResource(), nil)
+			resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw}}
+			r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var imported indexResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &imported)...)
+			if imported.Id.ValueString() != "test-env/movies" || imported.Name.ValueString() != "movies" {
+				t.Errorf("unexpected id %q and name %q", imported.Id.ValueString(), imported.Name.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// validateIndexConfig runs the attribute validators and ValidateConfig of
+// pinecone_index against a config with the given attributes set.
+func validateIndexConfig(t *testing.T, attributes map[string]tftypes.Value) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+
+	state := testState(t, NewIndexResource(), attributes)
+	config := tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+
+	var diags diag.Diagnostics
+	for name, attribute := range state.Schema.(schema.Schema).Attributes {
+		p := path.Root(name)
+		switch a := attribute.(type) {
+		case schema.StringAttribute:
+			var value types.String
+			diags.Append(config.GetAttribute(ctx, p, &value)...)
+			for _, v := range a.Validators {
+				resp := &validator.StringResponse{}
+				v.ValidateString(ctx, validator.StringRequest{Path: p, Config: config, ConfigValue: value}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+		case schema.Int64Attribute:
+			var value types.Int64
+			diags.Append(config.GetAttribute(ctx, p, &value)...)
+			for _, v := range a.Validators {
+				resp := &validator.Int64Response{}
+				v.ValidateInt64(ctx, validator.Int64Request{Path: p, Config: config, ConfigValue: value}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+		}
+	}
+
+	resp := &resource.ValidateConfigResponse{}
+	(&indexResource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+	diags.Append(resp.Diagnostics...)
+	return diags
+}
+
+func TestIndexValidateConfig(t *testing.T) {
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+	num := func(n int64) tftypes.Value { return tftypes.NewValue(tftypes.Number, n) }
+	embed := embedValue(t, "multilingual-e5-large", map[string]string{"text": "chunk_text"})
+
+	tests := []struct {
+		name       string
+		attributes map[string]tftypes.Value
+		// substring of the expected error summary, or "" for none
+		wantErr string
+	}{
+		{"valid", map[string]tftypes.Value{"name": str("my-index-1"), "dimension": num(1536)}, ""},
+		{"valid pods", map[string]tftypes.Value{"name": str("primary"), "dimension": num(8), "pods": num(6), "replicas": num(3), "pod_type": str("p2.x4")}, ""},
+		{"name too long", map[string]tftypes.Value{"name": str(strings.Repeat("a", 46)), "dimension": num(8)}, "Invalid Attribute Value Length"},
+		{"uppercase name", map[string]tftypes.Value{"name": str("MyIndex"), "dimension": num(8)}, "Invalid Attribute Value Match"},
+		{"name ends with a dash", map[string]tftypes.Value{"name": str("index-"), "dimension": num(8)}, "Invalid Attribute Value Match"},
+		{"missing name", map[string]tftypes.Value{"dimension": num(8)}, "Missing index name"},
+		{"dimension from source collection", map[string]tftypes.Value{"name": str("primary"), "source_collection": str("snapshot")}, ""},
+		{"missing dimension", map[string]tftypes.Value{"name": str("primary")}, "Missing dimension"},
+		{"dimension from source backup", map[string]tftypes.Value{"name": str("primary"), "source_backup": str("b1")}, ""},
+		{"pods and backup", map[string]tftypes.Value{"name": str("primary"), "source_backup": str("b1"), "pods": num(2)}, "Conflicting pod configuration"},
+		{"pod type and backup", map[string]tftypes.Value{"name": str("primary"), "source_backup": str("b1"), "pod_type": str("p1.x2")}, "Conflicting pod configuration"},
+		{"collection and backup", map[string]tftypes.Value{"name": str("primary"), "source_collection": str("snapshot"), "source_backup": str("b1")}, "Conflicting index sources"},
+		{"dimension from embed model", map[string]tftypes.Value{"name": str("primary"), "embed": embed}, ""},
+		{"dimension and embed", map[string]tftypes.Value{"name": str("primary"), "dimension": num(1024), "embed": embed}, "Conflicting dimension"},
+		{"replicas and embed", map[string]tftypes.Value{"name": str("primary"), "replicas": num(2), "embed": embed}, "Conflicting pod configuration"},
+		{"pod type and embed", map[string]tftypes.Value{"name": str("primary"), "pod_type": str("s1.x1"), "embed": embed}, "Conflicting pod configuration"},
+		{"backup and embed", map[string]tftypes.Value{"name": str("primary"), "source_backup": str("b1"), "embed": embed}, "Conflicting index sources"},
+		{"name prefix too long", map[string]tftypes.Value{"name_prefix": str(strings.Repeat("a", 31)), "dimension": num(8)}, "Invalid Attribute Value Length"},
+		{"dimension 0", map[string]tftypes.Value{"name": str("primary"), "dimension": num(0)}, "Invalid Attribute Value"},
+		{"dimension too large", map[string]tftypes.Value{"name": str("primary"), "dimension": num(20001)}, "Invalid Attribute Value"},
+		{"unknown metric", map[string]tftypes.Value{"name": str("primary"), "dimension": num(8), "metric": str("manhattan")}, "Invalid Attribute Value Match"},
+		{"pods not a multiple of replicas", map[string]tftypes.Value{"name": str("primary"), "dimension": num(8), "pods": num(3), "replicas": num(2)}, "Invalid number of pods"},
+		{"replicas without pods", map[string]tftypes.Value{"name": str("primary"), "dimension": num(8), "replicas": num(2)}, "Invalid number of pods"},
+		{"zero replicas", map[string]tftypes.Value{"name": str("primary"), "dimension": num(8), "replicas": num(0)}, "Invalid Attribute Value"},
+		{"invalid pod type", map[string]tftypes.Value{"name": str("primary"), "dimension": num(8), "pod_type": str("p1.x3")}, "Invalid Attribute Value Match"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateIndexConfig(t, tt.attributes)
+
+			if tt.wantErr == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+
+			for _, d := range diags.Errors() {
+				if strings.Contains(d.Summary(), tt.wantErr) {
+					return
+				}
+			}
+			t.Errorf("expected an error %q, got %v", tt.wantErr, diags)
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectResource{}
+	_ resource.ResourceWithConfigure   = &projectResource{}
+	_ resource.ResourceWithImportState = &projectResource{}
+)
+
+func NewProjectResource() resource.Resource {
+	return &projectResource{}
+}
+
+// projectResource is the resource implementation.
+type projectResource struct {
+	// this client is set by the provider
+	client services.AdminPlane
+}
+
+// projectResourceModel maps the resource schema data.
+type projectResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	MaxPods                 types.Int64  `tfsdk:"max_pods"`
+	ForceEncryptionWithCmek types.Bool   `tfsdk:"force_encryption_with_cmek"`
+	OrganizationId          types.String `tfsdk:"organization_id"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "projectResource.Metadata", map[string]any{"req": req, "resp": resp})
+
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "projectResource.Schema", map[string]any{"req": req, "resp": resp})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a project through the admin API. Requires the provider's ` + "`client_id` and `client_secret`" + `.
+
+A project must not contain any indexes or collections when it is destroyed. To manage the indexes of a project, configure a provider with its ` + "`project_id`" + `.
+- See [API Docs](https://docs.pinecone.io/reference/api/2025-04/admin/create_project)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service generated identifier of the project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the project.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"max_pods": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of pods that can be created in the project. Defaults to the limit of the organization's plan.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"force_encryption_with_cmek": schema.BoolAttribute{
+				MarkdownDescription: "Whether indexes of the project must be encrypted with a customer-managed encryption key. Once enabled, it cannot be disabled, so disabling it requires replacement. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.StateValue.ValueBool() && !req.PlanValue.ValueBool()
+						},
+						"Disabling CMEK encryption requires replacement.",
+						"Disabling CMEK encryption requires replacement.",
+					),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The id of the organization the project belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the project was created, as an RFC 3339 timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "projectResource.Configure", map[string]any{"req": req, "resp": resp})
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the client from the provider data
+	client, ok := req.ProviderData.(services.AdminPlane)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.AdminPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create a new resource.
+func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "projectResource.Create", map[string]any{"req": req, "resp": resp})
+	var plan projectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := services.CreateProjectRequest{
+		Name:                    plan.Name.ValueString(),
+		ForceEncryptionWithCmek: plan.ForceEncryptionWithCmek.ValueBoolPointer(),
+	}
+	if !plan.MaxPods.IsUnknown() {
+		data.MaxPods = plan.MaxPods.ValueInt64Pointer()
+	}
+
+	project, err := r.client.CreateProject(data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create project",
+			fmt.Sprintf("Failed to create project: %s", err),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "CreateProject OK", map[string]any{"response": *project})
+
+	plan.setComputed(project)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read resource information.
+func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "projectResource.Read", map[string]any{"req": req, "resp": resp})
+
+	var state projectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.DescribeProject(state.Id.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Warn(ctx, "Project not found, removing it from the state", map[string]any{"project": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe project",
+			err.Error(),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "DescribeProject OK", map[string]any{"response": *project})
+
+	state.setComputed(project)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update changes the name, max pods and encryption settings in place.
+func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "projectResource.Update", map[string]any{"req": req, "resp": resp})
+
+	var plan, state projectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only send what changed
+	data := services.UpdateProjectRequest{}
+	if !plan.Name.Equal(state.Name) {
+		data.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.MaxPods.IsUnknown() && !plan.MaxPods.Equal(state.MaxPods) {
+		data.MaxPods = plan.MaxPods.ValueInt64Pointer()
+	}
+	if !plan.ForceEncryptionWithCmek.Equal(state.ForceEncryptionWithCmek) {
+		data.ForceEncryptionWithCmek = plan.ForceEncryptionWithCmek.ValueBoolPointer()
+	}
+
+	project, err := r.client.UpdateProject(state.Id.ValueString(), data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update project",
+			fmt.Sprintf("Failed to update project: %s", err),
+		)
+		return
+	}
+
+	// log the response
+	tflog.Info(ctx, "UpdateProject OK", map[string]any{"response": *project})
+
+	plan.setComputed(project)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resource information.
+func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "projectResource.Delete", map[string]any{"req": req, "resp": resp})
+
+	var state projectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteProject(state.Id.ValueString())
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to delete project",
+			fmt.Sprintf("Failed to delete project: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "DeleteProject OK", map[string]any{"project": state.Id.ValueString()})
+}
+
+// ImportState imports a project by id.
+func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "projectResource.ImportState", map[string]any{"req": req, "resp": resp})
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// setComputed copies the attributes reported by describe_project.
+func (m *projectResourceModel) setComputed(project *services.Project) {
+	m.Id = types.StringValue(project.Id)
+	m.Name = types.StringValue(project.Name)
+	m.MaxPods = types.Int64Value(project.MaxPods)
+	m.ForceEncryptionWithCmek = types.BoolValue(project.ForceEncryptionWithCmek)
+	m.OrganizationId = types.StringValue(project.OrganizationId)
+	m.CreatedAt = types.StringValue(project.CreatedAt)
+}
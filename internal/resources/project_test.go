@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+func (f *fakeAdminPlane) UpdateProject(id string, data services.UpdateProjectRequest) (*services.Project, error) {
+	f.projectUpdates = append(f.projectUpdates, data)
+	project := &services.Project{Id: id, Name: "staging", MaxPods: 10, OrganizationId: "org", CreatedAt: "2024-01-01T00:00:00Z"}
+	if data.Name != nil {
+		project.Name = *data.Name
+	}
+	if data.MaxPods != nil {
+		project.MaxPods = *data.MaxPods
+	}
+	return project, nil
+}
+
+func TestProjectUpdateSendsChanges(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeAdminPlane{}
+	r := &projectResource{client: fake}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	project := func(maxPods int64) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":                         tftypes.NewValue(tftypes.String, "p1"),
+			"name":                       tftypes.NewValue(tftypes.String, "staging"),
+			"max_pods":                   tftypes.NewValue(tftypes.Number, maxPods),
+			"force_encryption_with_cmek": tftypes.NewValue(tftypes.Bool, false),
+			"organization_id":            tftypes.NewValue(tftypes.String, "org"),
+			"created_at":                 tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+		})
+	}
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: project(20)},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: project(10)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if len(fake.projectUpdates) != 1 {
+		t.Fatalf("expected one UpdateProject call, got %d", len(fake.projectUpdates))
+	}
+	update := fake.projectUpdates[0]
+	if update.Name != nil || update.ForceEncryptionWithCmek != nil || update.MaxPods == nil || *update.MaxPods != 20 {
+		t.Errorf("expected only max_pods to be sent, got %+v", update)
+	}
+
+	var got projectResourceModel
+	resp.State.Get(ctx, &got)
+	if got.MaxPods.ValueInt64() != 20 {
+		t.Errorf("expected max_pods 20 in state, got %d", got.MaxPods.ValueInt64())
+	}
+}
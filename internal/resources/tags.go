@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsAttributes returns the tags and tags_all attributes shared by taggable
+// resources. tags_all is set by modifyPlanTagsAll.
+func tagsAttributes(description string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"tags": schema.MapAttribute{
+			Description: description + " Merged with the provider's default_tags, which these take precedence over.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"tags_all": schema.MapAttribute{
+			Description: "The tags of the resource, including the provider's default_tags.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+// mergeTags returns defaults overridden by tags.
+func mergeTags(defaults map[string]string, tags map[string]string) map[string]string {
+	merged := map[string]string{}
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// tagChanges returns the tags to send to configure_index to turn old into
+// new: tags that were added or changed, and "" for tags that were removed.
+func tagChanges(old map[string]string, new map[string]string) map[string]string {
+	changes := map[string]string{}
+	for k, v := range new {
+		if current, ok := old[k]; !ok || current != v {
+			changes[k] = v
+		}
+	}
+	for k := range old {
+		if _, ok := new[k]; !ok {
+			changes[k] = ""
+		}
+	}
+	return changes
+}
+
+// modifyPlanTagsAll plans tags_all from the configured tags and defaults.
+func modifyPlanTagsAll(ctx context.Context, defaults map[string]string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if tags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	tagsAll, diags := tagsAllValue(ctx, defaults, tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// tagsAllValue merges defaults with a known tags value.
+func tagsAllValue(ctx context.Context, defaults map[string]string, tags types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configured := map[string]string{}
+	if !tags.IsNull() {
+		diags.Append(tags.ElementsAs(ctx, &configured, false)...)
+	}
+
+	tagsAll, d := types.MapValueFrom(ctx, types.StringType, mergeTags(defaults, configured))
+	diags.Append(d...)
+	return tagsAll, diags
+}
+
+// tagsMap converts a known tags_all value into a map.
+func tagsMap(ctx context.Context, tags types.Map) (map[string]string, diag.Diagnostics) {
+	m := map[string]string{}
+	if tags.IsNull() || tags.IsUnknown() {
+		return m, nil
+	}
+	diags := tags.ElementsAs(ctx, &m, false)
+	return m, diags
+}
@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMergeTags(t *testing.T) {
+	got := mergeTags(
+		map[string]string{"team": "platform", "cost_center": "1234"},
+		map[string]string{"team": "search"},
+	)
+
+	want := map[string]string{"team": "search", "cost_center": "1234"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestTagChanges(t *testing.T) {
+	got := tagChanges(
+		map[string]string{"team": "platform", "env": "prod", "owner": "alice"},
+		map[string]string{"team": "search", "env": "prod", "cost_center": "1234"},
+	)
+
+	want := map[string]string{"team": "search", "cost_center": "1234", "owner": ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestIndexModifyPlanTagsAll(t *testing.T) {
+	ctx := context.Background()
+	r := &indexResource{defaultTags: map[string]string{"team": "platform", "cost_center": "1234"}}
+
+	planned := testState(t, NewIndexResource(), map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "primary"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"team": tftypes.NewValue(tftypes.String, "search"),
+		}),
+	})
+	plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var tagsAll types.Map
+	resp.Plan.GetAttribute(ctx, path.Root("tags_all"), &tagsAll)
+	got, _ := tagsMap(ctx, tagsAll)
+
+	want := map[string]string{"team": "search", "cost_center": "1234"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected tags_all %v, got %v", want, got)
+	}
+}
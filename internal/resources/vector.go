@@ -0,0 +1,413 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &vectorResource{}
+	_ resource.ResourceWithConfigure  = &vectorResource{}
+	_ resource.ResourceWithModifyPlan = &vectorResource{}
+)
+
+func NewVectorResource() resource.Resource {
+	return &vectorResource{}
+}
+
+// vectorResource is the resource implementation.
+type vectorResource struct {
+	// these clients are set by the provider
+	client    services.ControlPlane
+	dataPlane services.DataPlane
+}
+
+// vectorResourceModel maps the resource schema data.
+type vectorResourceModel struct {
+	Id           types.String `tfsdk:"id"` // for TF
+	IndexName    types.String `tfsdk:"index_name"`
+	Namespace    types.String `tfsdk:"namespace"`
+	VectorId     types.String `tfsdk:"vector_id"`
+	Values       types.List   `tfsdk:"values"`
+	SparseValues types.Object `tfsdk:"sparse_values"`
+	Metadata     types.Map    `tfsdk:"metadata"`
+}
+
+// sparseValuesModel maps the sparse_values nested attribute.
+type sparseValuesModel struct {
+	Indices types.List `tfsdk:"indices"`
+	Values  types.List `tfsdk:"values"`
+}
+
+// Metadata returns the resource type name.
+func (r *vectorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "vectorResource.Metadata", map[string]any{"req": req, "resp": resp})
+
+	resp.TypeName = req.ProviderTypeName + "_vector"
+}
+
+// Schema defines the schema for the resource.
+func (r *vectorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "vectorResource.Schema", map[string]any{"req": req, "resp": resp})
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a single vector in an index. Supports sparse-dense (hybrid) vectors on dotproduct indexes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service generated identifier.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index_name": schema.StringAttribute{
+				Description: "The name of the index to upsert the vector into.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace to upsert the vector into.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vector_id": schema.StringAttribute{
+				Description: "The vector's unique id.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.ListAttribute{
+				Description: "The dense vector data. Its length must match the dimension of the index.",
+				ElementType: types.Float64Type,
+				Required:    true,
+			},
+			"sparse_values": schema.SingleNestedAttribute{
+				Description: "Vector sparse data, used for hybrid search. Only supported by indexes using the dotproduct metric.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"indices": schema.ListAttribute{
+						Description: "The indices of the non-zero sparse values.",
+						ElementType: types.Int64Type,
+						Required:    true,
+					},
+					"values": schema.ListAttribute{
+						Description: "The sparse values. Must be the same length as indices.",
+						ElementType: types.Float64Type,
+						Required:    true,
+					},
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "The metadata to store with the vector.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan checks that the index supports sparse_values, so that a metric
+// other than dotproduct is reported at plan time rather than during apply.
+func (r *vectorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "vectorResource.ModifyPlan", map[string]any{"req": req, "resp": resp})
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan vectorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.SparseValues.IsNull() || plan.IndexName.IsUnknown() {
+		return
+	}
+
+	index, err := r.client.DescribeIndex(plan.IndexName.ValueString())
+	if services.IsNotFound(err) {
+		// the index may be created by the same apply, upsert checks it then
+		tflog.Debug(ctx, "Index not found, checking sparse values during apply", map[string]any{"index": plan.IndexName.ValueString()})
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe index",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := services.ValidateSparseValues(index.Database.Metric, &services.SparseValues{}); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sparse_values"),
+			"Invalid sparse values",
+			fmt.Sprintf("Index %q: %s", plan.IndexName.ValueString(), err),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vectorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "vectorResource.Configure", map[string]any{"req": req, "resp": resp})
+	if req.ProviderData == nil {
+		return
+	}
+
+	// extract the clients from the provider data
+	client, ok := req.ProviderData.(services.ControlPlane)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.ControlPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	dataPlane, ok := req.ProviderData.(services.DataPlane)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected pinecone.DataPlane, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	r.dataPlane = dataPlane
+}
+
+// toVector converts the model into the data plane representation.
+func (m *vectorResourceModel) toVector(ctx context.Context) (*services.Vector, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	vector := &services.Vector{
+		Id: m.VectorId.ValueString(),
+	}
+
+	var values []float64
+	diags.Append(m.Values.ElementsAs(ctx, &values, false)...)
+	for _, v := range values {
+		vector.Values = append(vector.Values, float32(v))
+	}
+
+	if !m.SparseValues.IsNull() && !m.SparseValues.IsUnknown() {
+		var sparse sparseValuesModel
+		diags.Append(m.SparseValues.As(ctx, &sparse, basetypes.ObjectAsOptions{})...)
+
+		var indices []int64
+		var sparseValues []float64
+		diags.Append(sparse.Indices.ElementsAs(ctx, &indices, false)...)
+		diags.Append(sparse.Values.ElementsAs(ctx, &sparseValues, false)...)
+
+		vector.SparseValues = &services.SparseValues{}
+		for _, i := range indices {
+			if i < 0 || i > math.MaxUint32 {
+				diags.AddAttributeError(
+					path.Root("sparse_values").AtName("indices"),
+					"Invalid sparse values",
+					fmt.Sprintf("Sparse value indices must be between 0 and %d, got %d.", uint32(math.MaxUint32), i),
+				)
+				continue
+			}
+			vector.SparseValues.Indices = append(vector.SparseValues.Indices, uint32(i))
+		}
+		for _, v := range sparseValues {
+			vector.SparseValues.Values = append(vector.SparseValues.Values, float32(v))
+		}
+	}
+
+	if !m.Metadata.IsNull() && !m.Metadata.IsUnknown() {
+		var metadata map[string]string
+		diags.Append(m.Metadata.ElementsAs(ctx, &metadata, false)...)
+
+		vector.Metadata = map[string]interface{}{}
+		for k, v := range metadata {
+			vector.Metadata[k] = v
+		}
+	}
+
+	return vector, diags
+}
+
+// upsert writes the planned vector into its index, after checking that the
+// index's metric supports sparse values when they are present.
+func (r *vectorResource) upsert(ctx context.Context, plan *vectorResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vector, d := plan.toVector(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	index, err := r.client.DescribeIndex(plan.IndexName.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Failed to describe index",
+			err.Error(),
+		)
+		return diags
+	}
+
+	if err := services.ValidateSparseValues(index.Database.Metric, vector.SparseValues); err != nil {
+		diags.AddAttributeError(
+			path.Root("sparse_values"),
+			"Invalid sparse values",
+			fmt.Sprintf("Index %q: %s", plan.IndexName.ValueString(), err),
+		)
+		return diags
+	}
+
+	response, err := r.dataPlane.Upsert(index.Status.Host, services.UpsertRequest{
+		Vectors:   []services.Vector{*vector},
+		Namespace: plan.Namespace.ValueString(),
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to upsert vector",
+			fmt.Sprintf("Failed to upsert vector: %s", err),
+		)
+		return diags
+	}
+
+	// log the response
+	tflog.Info(ctx, "Upsert OK", map[string]any{"response": *response})
+
+	return diags
+}
+
+// Create a new resource.
+func (r *vectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "vectorResource.Create", map[string]any{"req": req, "resp": resp})
+	var plan vectorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.upsert(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", plan.IndexName.ValueString(), plan.Namespace.ValueString(), plan.VectorId.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read resource information.
+func (r *vectorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "vectorResource.Read", map[string]any{"req": req, "resp": resp})
+
+	var state vectorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index, err := r.client.DescribeIndex(state.IndexName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe index",
+			err.Error(),
+		)
+		return
+	}
+
+	response, err := r.dataPlane.Fetch(index.Status.Host, state.Namespace.ValueString(), []string{state.VectorId.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch vector",
+			err.Error(),
+		)
+		return
+	}
+
+	// The vector no longer exists; let terraform recreate it.
+	if _, ok := response.Vectors[state.VectorId.ValueString()]; !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Values are stored as float32 by Pinecone, so they are not copied back
+	// into state; doing so would produce a perpetual diff from rounding.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource information.
+func (r *vectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "vectorResource.Update", map[string]any{"req": req, "resp": resp})
+
+	var plan vectorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// upserting an existing id overwrites the previous value
+	resp.Diagnostics.Append(r.upsert(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resource information.
+func (r *vectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "vectorResource.Delete", map[string]any{"req": req, "resp": resp})
+
+	var state vectorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index, err := r.client.DescribeIndex(state.IndexName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe index",
+			err.Error(),
+		)
+		return
+	}
+
+	err = r.dataPlane.DeleteVectors(index.Status.Host, services.DeleteVectorsRequest{
+		Ids:       []string{state.VectorId.ValueString()},
+		Namespace: state.Namespace.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete vector",
+			fmt.Sprintf("Failed to delete vector: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "DeleteVectors OK", map[string]any{"id": state.Id.ValueString()})
+}
@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	services "github.com/thiskevinwang/terraform-provider-pinecone/internal/services"
+)
+
+func TestToVectorSparseIndexRange(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		index   int64
+		wantErr bool
+	}{
+		{"zero", 0, false},
+		{"largest", math.MaxUint32, false},
+		{"negative", -1, true},
+		{"too large", math.MaxUint32 + 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sparseValues, diags := types.ObjectValue(
+				map[string]attr.Type{
+					"indices": types.ListType{ElemType: types.Int64Type},
+					"values":  types.ListType{ElemType: types.Float64Type},
+				},
+				map[string]attr.Value{
+					"indices": types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(tt.index)}),
+					"values":  types.ListValueMust(types.Float64Type, []attr.Value{types.Float64Value(0.5)}),
+				},
+			)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			m := vectorResourceModel{
+				VectorId:     types.StringValue("a"),
+				Values:       types.ListValueMust(types.Float64Type, []attr.Value{types.Float64Value(0.1)}),
+				SparseValues: sparseValues,
+				Metadata:     types.MapNull(types.StringType),
+			}
+			vector, diags := m.toVector(ctx)
+
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, diags)
+			}
+			if !tt.wantErr && vector.SparseValues.Indices[0] != uint32(tt.index) {
+				t.Errorf("unexpected indices %v", vector.SparseValues.Indices)
+			}
+		})
+	}
+}
+
+func TestVectorModifyPlanSparseValuesMetric(t *testing.T) {
+	ctx := context.Background()
+	cosine := &services.DescribeIndexResponse{}
+	cosine.Database.Metric = "cosine"
+	dotproduct := &services.DescribeIndexResponse{}
+	dotproduct.Database.Metric = "dotproduct"
+	r := &vectorResource{client: &fakeControlPlane{indexes: map[string]*services.DescribeIndexResponse{"movies": cosine, "hybrid": dotproduct}}}
+
+	sparseValues := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"indices": tftypes.List{ElementType: tftypes.Number},
+		"values":  tftypes.List{ElementType: tftypes.Number},
+	}}, map[string]tftypes.Value{
+		"indices": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{tftypes.NewValue(tftypes.Number, 3)}),
+		"values":  tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{tftypes.NewValue(tftypes.Number, 0.5)}),
+	})
+
+	tests := []struct {
+		name    string
+		index   interface{}
+		wantErr bool
+	}{
+		{"dotproduct index", "hybrid", false},
+		{"cosine index", "movies", true},
+		{"index created by the same apply", "missing", false},
+		{"unknown index", tftypes.UnknownValue, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planned := testState(t, NewVectorResource(), map[string]tftypes.Value{
+				"index_name":    tftypes.NewValue(tftypes.String, tt.index),
+				"vector_id":     tftypes.NewValue(tftypes.String, "a"),
+				"sparse_values": sparseValues,
+			})
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,559 @@
+package pinecone
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	adminBaseUrl = "https://api.pinecone.io/admin"
+	tokenBaseUrl = "https://login.pinecone.io/oauth/token"
+	// Sent as X-Pinecone-Api-Version with every admin API request.
+	adminApiVersion = "2025-04"
+	// Tokens are refreshed this long before they expire.
+	tokenExpiryMargin = time.Minute
+)
+
+// AdminPlane is the set of admin API operations, which manage the
+// organization rather than a single project. It is satisfied by *Client,
+// and by fakes in unit tests.
+type AdminPlane interface {
+	ListApiKeys(projectId string) ([]ApiKey, error)
+	CreateApiKey(projectId string, data CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	DescribeApiKey(id string) (*ApiKey, error)
+	DeleteApiKey(id string) error
+	ListProjects() ([]Project, error)
+	CreateProject(data CreateProjectRequest) (*Project, error)
+	DescribeProject(id string) (*Project, error)
+	UpdateProject(id string, data UpdateProjectRequest) (*Project, error)
+	DeleteProject(id string) error
+}
+
+var _ AdminPlane = &Client{}
+
+// ErrNoServiceAccount is returned by admin API operations when the client has
+// no service account to authenticate with.
+var ErrNoServiceAccount = errors.New("the admin API requires a service account, set client_id and client_secret in the provider configuration")
+
+// ServiceAccount holds the client credentials the admin API is authenticated
+// with. Service accounts are created in the organization settings of the console.
+type ServiceAccount struct {
+	ClientId     string
+	ClientSecret string
+}
+
+// adminToken is the access token exchanged for the service account. It is
+// shared by every admin API request of the client.
+type adminToken struct {
+	mu      sync.Mutex
+	value   string
+	expires time.Time
+}
+
+type tokenRequest struct {
+	GrantType    string `json:"grant_type"`
+	ClientId     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	Audience     string `json:"audience"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	// Lifetime of the token, in seconds
+	ExpiresIn int64 `json:"expires_in"`
+}
+
+// token
+// POST
+// https://login.pinecone.io/oauth/token
+// Exchanges the service account credentials for an access token. The token is
+// reused until shortly before it expires.
+//
+// 200 JSON - The access token
+// 401 JSON - Unauthorized. The client id or secret is invalid.
+func (c *Client) accessToken() (string, error) {
+	if c.ServiceAccount == nil {
+		return "", ErrNoServiceAccount
+	}
+
+	c.token.mu.Lock()
+	defer c.token.mu.Unlock()
+
+	if c.token.value != "" && time.Now().Add(tokenExpiryMargin).Before(c.token.expires) {
+		return c.token.value, nil
+	}
+
+	payload, err := json.Marshal(tokenRequest{
+		GrantType:    "client_credentials",
+		ClientId:     c.ServiceAccount.ClientId,
+		ClientSecret: c.ServiceAccount.ClientSecret,
+		Audience:     "https://api.pinecone.io/",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("POST", c.tokenUrl, bytes.NewBuffer(payload))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("content-type", "application/json")
+
+	res, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		token := &tokenResponse{}
+		err := json.Unmarshal(body, token)
+		if err != nil {
+			return "", err
+		}
+		c.token.value = token.AccessToken
+		c.token.expires = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+		return c.token.value, nil
+	default: // non-2xx
+		return "", &APIError{Operation: "AccessToken", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// newAdminRequest initializes an admin API request authenticated with the
+// service account's access token.
+func (c *Client) newAdminRequest(method string, path string, body io.Reader) (*http.Request, error) {
+	token, err := c.accessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, c.adminUrl+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("Authorization", "Bearer "+token)
+	req.Header.Add("X-Pinecone-Api-Version", adminApiVersion)
+	if body != nil {
+		req.Header.Add("content-type", "application/json")
+	}
+
+	return req, nil
+}
+
+// ApiKeyRoles are the roles that can be granted to an API key.
+var ApiKeyRoles = []string{"ProjectEditor", "ProjectViewer", "ControlPlaneEditor", "ControlPlaneViewer", "DataPlaneEditor", "DataPlaneViewer"}
+
+type ApiKey struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	ProjectId string `json:"project_id"`
+	// values: see ApiKeyRoles
+	Roles []string `json:"roles"`
+}
+
+type CreateApiKeyRequest struct {
+	// The name of the API key. The maximum length is 80 characters.
+	Name string `json:"name"`
+	// The roles granted to the API key. Defaults to ProjectEditor.
+	Roles []string `json:"roles,omitempty"`
+}
+
+type CreateApiKeyResponse struct {
+	Key ApiKey `json:"key"`
+	// The secret value of the API key. It is only returned when the key is created.
+	Value string `json:"value"`
+}
+
+type listApiKeysResponse struct {
+	Data []ApiKey `json:"data"`
+}
+
+// list_api_keys
+// GET
+// https://api.pinecone.io/admin/projects/{project_id}/api-keys
+// This operation returns the API keys of a project. Secret values are not included.
+//
+// 200 JSON - The API keys of the project
+// 404 JSON - Project not found.
+func (c *Client) ListApiKeys(projectId string) ([]ApiKey, error) {
+	req, err := c.newAdminRequest("GET", fmt.Sprintf("/projects/%s/api-keys", projectId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		listResponse := &listApiKeysResponse{}
+		err := json.Unmarshal(body, listResponse)
+		if err != nil {
+			return nil, err
+		}
+		return listResponse.Data, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "ListApiKeys", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// create_api_key
+// POST
+// https://api.pinecone.io/admin/projects/{project_id}/api-keys
+// This operation creates an API key for a project. The response is the only
+// time the secret value is returned.
+//
+// 201 JSON - The API key and its secret value
+// 400 JSON - Bad request. The name or roles are invalid.
+// 404 JSON - Project not found.
+func (c *Client) CreateApiKey(projectId string, data CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newAdminRequest("POST", fmt.Sprintf("/projects/%s/api-keys", projectId), bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		createResponse := &CreateApiKeyResponse{}
+		err := json.Unmarshal(body, createResponse)
+		if err != nil {
+			return nil, err
+		}
+		return createResponse, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "CreateApiKey", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// describe_api_key
+// GET
+// https://api.pinecone.io/admin/api-keys/{api_key_id}
+// This operation returns an API key, without its secret value.
+//
+// 200 JSON - The API key
+// 404 JSON - API key not found.
+func (c *Client) DescribeApiKey(id string) (*ApiKey, error) {
+	req, err := c.newAdminRequest("GET", fmt.Sprintf("/api-keys/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		apiKey := &ApiKey{}
+		err := json.Unmarshal(body, apiKey)
+		if err != nil {
+			return nil, err
+		}
+		return apiKey, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "DescribeApiKey", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// delete_api_key
+// DELETE
+// https://api.pinecone.io/admin/api-keys/{api_key_id}
+// This operation deletes an API key. Requests authenticated with it fail immediately.
+//
+// 202 - The API key was deleted
+// 404 JSON - API key not found.
+func (c *Client) DeleteApiKey(id string) error {
+	req, err := c.newAdminRequest("DELETE", fmt.Sprintf("/api-keys/%s", id), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		return nil
+	default: // non-2xx
+		return &APIError{Operation: "DeleteApiKey", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+type Project struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	// The maximum number of pods that can be created in the project
+	MaxPods int64 `json:"max_pods"`
+	// Whether indexes of the project must be encrypted with a customer-managed encryption key
+	ForceEncryptionWithCmek bool   `json:"force_encryption_with_cmek"`
+	OrganizationId          string `json:"organization_id"`
+	// RFC 3339 timestamp
+	CreatedAt string `json:"created_at"`
+}
+
+type CreateProjectRequest struct {
+	// The name of the project. The maximum length is 512 characters.
+	Name string `json:"name"`
+	// Defaults to the limit of the organization's plan.
+	MaxPods *int64 `json:"max_pods,omitempty"`
+	// Defaults to false. Cannot be disabled once enabled.
+	ForceEncryptionWithCmek *bool `json:"force_encryption_with_cmek,omitempty"`
+}
+
+// Only the fields that are set are changed.
+type UpdateProjectRequest struct {
+	Name                    *string `json:"name,omitempty"`
+	MaxPods                 *int64  `json:"max_pods,omitempty"`
+	ForceEncryptionWithCmek *bool   `json:"force_encryption_with_cmek,omitempty"`
+}
+
+type listProjectsResponse struct {
+	Data []Project `json:"data"`
+}
+
+// list_projects
+// GET
+// https://api.pinecone.io/admin/projects
+// This operation returns the projects of the organization the service account belongs to.
+//
+// 200 JSON - The projects of the organization
+func (c *Client) ListProjects() ([]Project, error) {
+	req, err := c.newAdminRequest("GET", "/projects", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		listResponse := &listProjectsResponse{}
+		err := json.Unmarshal(body, listResponse)
+		if err != nil {
+			return nil, err
+		}
+		return listResponse.Data, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "ListProjects", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// create_project
+// POST
+// https://api.pinecone.io/admin/projects
+// This operation creates a project in the organization the service account belongs to.
+//
+// 201 JSON - The project
+// 400 JSON - Bad request. The name or max pods are invalid.
+// 409 JSON - A project with the name already exists.
+func (c *Client) CreateProject(data CreateProjectRequest) (*Project, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newAdminRequest("POST", "/projects", bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		project := &Project{}
+		err := json.Unmarshal(body, project)
+		if err != nil {
+			return nil, err
+		}
+		return project, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "CreateProject", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// describe_project
+// GET
+// https://api.pinecone.io/admin/projects/{project_id}
+// This operation returns a project.
+//
+// 200 JSON - The project
+// 404 JSON - Project not found.
+func (c *Client) DescribeProject(id string) (*Project, error) {
+	req, err := c.newAdminRequest("GET", fmt.Sprintf("/projects/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		project := &Project{}
+		err := json.Unmarshal(body, project)
+		if err != nil {
+			return nil, err
+		}
+		return project, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "DescribeProject", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// update_project
+// PATCH
+// https://api.pinecone.io/admin/projects/{project_id}
+// This operation changes the name, max pods or encryption settings of a project.
+//
+// 200 JSON - The updated project
+// 400 JSON - Bad request, ex. disabling CMEK encryption.
+// 404 JSON - Project not found.
+func (c *Client) UpdateProject(id string, data UpdateProjectRequest) (*Project, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newAdminRequest("PATCH", fmt.Sprintf("/projects/%s", id), bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		project := &Project{}
+		err := json.Unmarshal(body, project)
+		if err != nil {
+			return nil, err
+		}
+		return project, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "UpdateProject", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// delete_project
+// DELETE
+// https://api.pinecone.io/admin/projects/{project_id}
+// This operation deletes a project. The project must not contain any indexes or collections.
+//
+// 202 - The project was deleted
+// 404 JSON - Project not found.
+// 412 JSON - The project still contains indexes or collections.
+func (c *Client) DeleteProject(id string) error {
+	req, err := c.newAdminRequest("DELETE", fmt.Sprintf("/projects/%s", id), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		return nil
+	default: // non-2xx
+		return &APIError{Operation: "DeleteProject", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
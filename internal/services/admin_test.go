@@ -0,0 +1,192 @@
+package pinecone
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAdminApiKeys(t *testing.T) {
+	tokenRequests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			tokenRequests++
+			var got tokenRequest
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &got)
+			if got.GrantType != "client_credentials" || got.ClientId != "id" || got.ClientSecret != "secret" {
+				t.Errorf("unexpected token request %+v", got)
+			}
+			w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("X-Pinecone-Api-Version") != adminApiVersion {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /admin/projects/proj/api-keys":
+			var got CreateApiKeyRequest
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &got)
+			if got.Name != "search" || len(got.Roles) != 1 || got.Roles[0] != "DataPlaneViewer" {
+				t.Errorf("unexpected create request %+v", got)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"key":{"id":"k1","name":"search","project_id":"proj","roles":["DataPlaneViewer"]},"value":"pcsk_secret"}`))
+		case "GET /admin/projects/proj/api-keys":
+			w.Write([]byte(`{"data":[{"id":"k1","name":"search","project_id":"proj","roles":["DataPlaneViewer"]}]}`))
+		case "GET /admin/api-keys/k1":
+			w.Write([]byte(`{"id":"k1","name":"search","project_id":"proj","roles":["DataPlaneViewer"]}`))
+		case "DELETE /admin/api-keys/k1":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":"NOT_FOUND"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.adminUrl = srv.URL + "/admin"
+	c.tokenUrl = srv.URL + "/oauth/token"
+
+	if _, err := c.ListApiKeys("proj"); !errors.Is(err, ErrNoServiceAccount) {
+		t.Fatalf("expected ErrNoServiceAccount without a service account, got %v", err)
+	}
+
+	c.ServiceAccount = &ServiceAccount{ClientId: "id", ClientSecret: "secret"}
+
+	created, err := c.CreateApiKey("proj", CreateApiKeyRequest{Name: "search", Roles: []string{"DataPlaneViewer"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if created.Key.Id != "k1" || created.Value != "pcsk_secret" {
+		t.Errorf("unexpected create response %+v", created)
+	}
+
+	keys, err := c.ListApiKeys("proj")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(keys) != 1 || keys[0].Name != "search" {
+		t.Errorf("unexpected keys %+v", keys)
+	}
+
+	key, err := c.DescribeApiKey("k1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if key.ProjectId != "proj" {
+		t.Errorf("unexpected key %+v", key)
+	}
+
+	if err := c.DeleteApiKey("k1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.DescribeApiKey("missing"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if tokenRequests != 1 {
+		t.Errorf("expected the access token to be reused, got %d token requests", tokenRequests)
+	}
+}
+
+func TestAdminProjects(t *testing.T) {
+	var updates []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /oauth/token":
+			w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+		case "POST /admin/projects":
+			var got CreateProjectRequest
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &got)
+			if got.Name != "staging" || *got.MaxPods != 10 || got.ForceEncryptionWithCmek != nil {
+				t.Errorf("unexpected create request %s", body)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"p1","name":"staging","max_pods":10,"force_encryption_with_cmek":false,"organization_id":"org"}`))
+		case "PATCH /admin/projects/p1":
+			var got map[string]interface{}
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &got)
+			updates = append(updates, got)
+			w.Write([]byte(`{"id":"p1","name":"staging","max_pods":20,"force_encryption_with_cmek":false,"organization_id":"org"}`))
+		case "GET /admin/projects":
+			w.Write([]byte(`{"data":[{"id":"p1","name":"staging","max_pods":20}]}`))
+		case "DELETE /admin/projects/p1":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("", "test")
+	c.adminUrl = srv.URL + "/admin"
+	c.tokenUrl = srv.URL + "/oauth/token"
+	c.ServiceAccount = &ServiceAccount{ClientId: "id", ClientSecret: "secret"}
+
+	maxPods := int64(10)
+	project, err := c.CreateProject(CreateProjectRequest{Name: "staging", MaxPods: &maxPods})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if project.Id != "p1" || project.OrganizationId != "org" {
+		t.Errorf("unexpected project %+v", project)
+	}
+
+	maxPods = 20
+	if project, err = c.UpdateProject("p1", UpdateProjectRequest{MaxPods: &maxPods}); err != nil || project.MaxPods != 20 {
+		t.Fatalf("unexpected update response %+v, %v", project, err)
+	}
+	// only the fields that change are sent
+	if len(updates) != 1 || len(updates[0]) != 1 || updates[0]["max_pods"] != float64(20) {
+		t.Errorf("unexpected update requests %v", updates)
+	}
+
+	projects, err := c.ListProjects()
+	if err != nil || len(projects) != 1 {
+		t.Fatalf("unexpected projects %+v, %v", projects, err)
+	}
+
+	if err := c.DeleteProject("p1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.DescribeProject("missing"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestProjectScopedAuthentication(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+			return
+		}
+		if r.Header.Get("Api-Key") != "" || r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("X-Project-Id") != "p1" {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		w.Write([]byte(`{"namespaces":{},"dimension":3,"totalVectorCount":0}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("", "test")
+	c.HTTPClient = srv.Client()
+	c.tokenUrl = srv.URL + "/oauth/token"
+	c.ServiceAccount = &ServiceAccount{ClientId: "id", ClientSecret: "secret"}
+	c.ProjectId = "p1"
+
+	if _, err := c.DescribeIndexStats(strings.TrimPrefix(srv.URL, "https://"), DescribeIndexStatsRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,406 @@
+package pinecone
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// The global control plane, which manages serverless indexes, backups and restore jobs.
+	apiBaseUrl = "https://api.pinecone.io"
+	// Sent as X-Pinecone-Api-Version with every global control plane request.
+	apiVersion = "2025-04"
+)
+
+// BackupPlane is the set of backup and restore job operations of the global
+// control plane. It is satisfied by *Client, and by fakes in unit tests.
+type BackupPlane interface {
+	CreateBackup(indexName string, data CreateBackupRequest) (*Backup, error)
+	ListBackups(indexName string) ([]Backup, error)
+	DescribeBackup(id string) (*Backup, error)
+	DeleteBackup(id string) error
+	CreateIndexFromBackup(id string, data CreateIndexFromBackupRequest) (*CreateIndexFromBackupResponse, error)
+	ListRestoreJobs() ([]RestoreJob, error)
+	DescribeRestoreJob(id string) (*RestoreJob, error)
+}
+
+var _ BackupPlane = &Client{}
+
+// newApiRequest initializes an authenticated global control plane request.
+func (c *Client) newApiRequest(method string, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, c.apiUrl+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("X-Pinecone-Api-Version", apiVersion)
+	if body != nil {
+		req.Header.Add("content-type", "application/json")
+	}
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type Backup struct {
+	Id              string `json:"backup_id"`
+	SourceIndexName string `json:"source_index_name"`
+	SourceIndexId   string `json:"source_index_id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	// values: Initializing, Ready, Failed
+	Status         string            `json:"status"`
+	Cloud          string            `json:"cloud"`
+	Region         string            `json:"region"`
+	Dimension      int64             `json:"dimension"`
+	Metric         string            `json:"metric"`
+	RecordCount    int64             `json:"record_count"`
+	NamespaceCount int64             `json:"namespace_count"`
+	SizeBytes      int64             `json:"size_bytes"`
+	Tags           map[string]string `json:"tags"`
+	// RFC 3339 timestamp
+	CreatedAt string `json:"created_at"`
+}
+
+type CreateBackupRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type listBackupsResponse struct {
+	Data       []Backup    `json:"data"`
+	Pagination *pagination `json:"pagination"`
+}
+
+// pagination is returned by list operations that have more results.
+type pagination struct {
+	Next string `json:"next"`
+}
+
+// create_backup
+// POST
+// https://api.pinecone.io/indexes/{index_name}/backups
+// This operation creates a backup of a serverless index.
+//
+// 201 JSON - The backup, which is Initializing
+// 400 JSON - Bad request, ex. the index is pod-based.
+// 404 JSON - Index not found.
+func (c *Client) CreateBackup(indexName string, data CreateBackupRequest) (*Backup, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newApiRequest("POST", fmt.Sprintf("/indexes/%s/backups", indexName), bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		backup := &Backup{}
+		err := json.Unmarshal(body, backup)
+		if err != nil {
+			return nil, err
+		}
+		return backup, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "CreateBackup", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// list_index_backups, list_project_backups
+// GET
+// https://api.pinecone.io/indexes/{index_name}/backups
+// https://api.pinecone.io/backups
+// This operation returns the backups of an index, or of the whole project
+// when indexName is empty. Every page of results is fetched.
+//
+// 200 JSON - A page of backups
+// 404 JSON - Index not found.
+func (c *Client) ListBackups(indexName string) ([]Backup, error) {
+	path := "/backups"
+	if indexName != "" {
+		path = fmt.Sprintf("/indexes/%s/backups", indexName)
+	}
+
+	backups := []Backup{}
+	next := ""
+	for {
+		pagePath := path
+		if next != "" {
+			pagePath += "?paginationToken=" + url.QueryEscape(next)
+		}
+
+		req, err := c.newApiRequest("GET", pagePath, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := c.do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode >= 300 { // non-2xx
+			return nil, &APIError{Operation: "ListBackups", StatusCode: res.StatusCode, Message: string(body)}
+		}
+
+		page := &listBackupsResponse{}
+		err = json.Unmarshal(body, page)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, page.Data...)
+
+		if page.Pagination == nil || page.Pagination.Next == "" {
+			return backups, nil
+		}
+		next = page.Pagination.Next
+	}
+}
+
+// describe_backup
+// GET
+// https://api.pinecone.io/backups/{backup_id}
+// This operation returns a backup.
+//
+// 200 JSON - The backup
+// 404 JSON - Backup not found.
+func (c *Client) DescribeBackup(id string) (*Backup, error) {
+	req, err := c.newApiRequest("GET", fmt.Sprintf("/backups/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		backup := &Backup{}
+		err := json.Unmarshal(body, backup)
+		if err != nil {
+			return nil, err
+		}
+		return backup, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "DescribeBackup", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// delete_backup
+// DELETE
+// https://api.pinecone.io/backups/{backup_id}
+// This operation deletes a backup.
+//
+// 202 - The backup was deleted
+// 404 JSON - Backup not found.
+func (c *Client) DeleteBackup(id string) error {
+	req, err := c.newApiRequest("DELETE", fmt.Sprintf("/backups/%s", id), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		return nil
+	default: // non-2xx
+		return &APIError{Operation: "DeleteBackup", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+type CreateIndexFromBackupRequest struct {
+	// The name of the index to be created. The maximum length is 45 characters.
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags,omitempty"`
+	// values: enabled, disabled
+	DeletionProtection string `json:"deletion_protection,omitempty"`
+}
+
+type CreateIndexFromBackupResponse struct {
+	RestoreJobId string `json:"restore_job_id"`
+	IndexId      string `json:"index_id"`
+}
+
+// create_index_from_backup
+// POST
+// https://api.pinecone.io/backups/{backup_id}/create-index
+// This operation creates an index from a backup. The index is populated by a
+// restore job, which runs after the operation returns.
+//
+// 202 JSON - The restore job that populates the index
+// 400 JSON - Bad request, ex. the backup is not Ready.
+// 404 JSON - Backup not found.
+// 409 JSON - An index with the name already exists.
+func (c *Client) CreateIndexFromBackup(id string, data CreateIndexFromBackupRequest) (*CreateIndexFromBackupResponse, error) {
+	defer c.cache.invalidate()
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newApiRequest("POST", fmt.Sprintf("/backups/%s/create-index", id), bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		createResponse := &CreateIndexFromBackupResponse{}
+		err := json.Unmarshal(body, createResponse)
+		if err != nil {
+			return nil, err
+		}
+		return createResponse, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "CreateIndexFromBackup", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+type RestoreJob struct {
+	Id              string `json:"restore_job_id"`
+	BackupId        string `json:"backup_id"`
+	TargetIndexName string `json:"target_index_name"`
+	TargetIndexId   string `json:"target_index_id"`
+	// values: Pending, InProgress, Completed, Failed
+	Status          string  `json:"status"`
+	PercentComplete float64 `json:"percent_complete"`
+	// RFC 3339 timestamps
+	CreatedAt   string `json:"created_at"`
+	CompletedAt string `json:"completed_at"`
+}
+
+type listRestoreJobsResponse struct {
+	Data       []RestoreJob `json:"data"`
+	Pagination *pagination  `json:"pagination"`
+}
+
+// list_restore_jobs
+// GET
+// https://api.pinecone.io/restore-jobs
+// This operation returns the restore jobs of the project. Only the first page
+// of results is returned.
+//
+// 200 JSON - A page of restore jobs
+func (c *Client) ListRestoreJobs() ([]RestoreJob, error) {
+	req, err := c.newApiRequest("GET", "/restore-jobs", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		listResponse := &listRestoreJobsResponse{}
+		err := json.Unmarshal(body, listResponse)
+		if err != nil {
+			return nil, err
+		}
+		return listResponse.Data, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "ListRestoreJobs", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// describe_restore_job
+// GET
+// https://api.pinecone.io/restore-jobs/{job_id}
+// This operation returns a restore job.
+//
+// 200 JSON - The restore job
+// 404 JSON - Restore job not found.
+func (c *Client) DescribeRestoreJob(id string) (*RestoreJob, error) {
+	req, err := c.newApiRequest("GET", fmt.Sprintf("/restore-jobs/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		restoreJob := &RestoreJob{}
+		err := json.Unmarshal(body, restoreJob)
+		if err != nil {
+			return nil, err
+		}
+		return restoreJob, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "DescribeRestoreJob", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
@@ -0,0 +1,81 @@
+package pinecone
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListBackupsPaginates(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Api-Key") != "key" || r.Header.Get("X-Pinecone-Api-Version") != apiVersion {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		if r.URL.Path != "/indexes/movies/backups" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("paginationToken") {
+		case "":
+			w.Write([]byte(`{"data":[{"backup_id":"b1","source_index_name":"movies","status":"Ready"}],"pagination":{"next":"page 2"}}`))
+		case "page 2":
+			w.Write([]byte(`{"data":[{"backup_id":"b2","source_index_name":"movies","status":"Initializing"}]}`))
+		default:
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.apiUrl = srv.URL
+
+	backups, err := c.ListBackups("movies")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(backups) != 2 || backups[0].Id != "b1" || backups[1].Status != "Initializing" {
+		t.Errorf("unexpected backups %+v", backups)
+	}
+}
+
+func TestRestoreFromBackup(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /backups/b1/create-index":
+			var got CreateIndexFromBackupRequest
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &got)
+			if got.Name != "movies-restored" || got.DeletionProtection != "enabled" {
+				t.Errorf("unexpected request %s", body)
+			}
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"restore_job_id":"rj1","index_id":"i1"}`))
+		case "GET /restore-jobs/rj1":
+			w.Write([]byte(`{"restore_job_id":"rj1","backup_id":"b1","target_index_name":"movies-restored","status":"InProgress","percent_complete":42.5}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.apiUrl = srv.URL
+
+	created, err := c.CreateIndexFromBackup("b1", CreateIndexFromBackupRequest{Name: "movies-restored", DeletionProtection: "enabled"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	job, err := c.DescribeRestoreJob(created.RestoreJobId)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if job.Status != "InProgress" || job.PercentComplete != 42.5 {
+		t.Errorf("unexpected restore job %+v", job)
+	}
+
+	if _, err := c.DescribeBackup("missing"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
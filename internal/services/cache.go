@@ -0,0 +1,82 @@
+package pinecone
+
+import (
+	"sync"
+	"time"
+)
+
+// How long a describe or list response is reused. Long enough to cover the
+// refresh of a large plan, short enough that an apply sees fresh state.
+const defaultCacheTTL = 30 * time.Second
+
+// responseCache is a short-lived cache of control plane read responses, so
+// that many resources and data sources referring to the same index or
+// collection during a plan result in a single request. Any mutating call
+// invalidates the whole cache.
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+	// incremented on every invalidation, so that a read which was in flight
+	// while a mutation completed does not store a stale response
+	generation uint64
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: map[string]cacheEntry{},
+	}
+}
+
+// get returns the cached value for key and the current generation, to be
+// passed back to set. A nil cache never hits.
+func (r *responseCache) get(key string) (interface{}, uint64, bool) {
+	if r == nil {
+		return nil, 0, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		delete(r.entries, key)
+		return nil, r.generation, false
+	}
+	return entry.value, r.generation, true
+}
+
+// set stores value for key, unless the cache was invalidated since generation
+// was read.
+func (r *responseCache) set(key string, generation uint64, value interface{}) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if generation != r.generation {
+		return
+	}
+	r.entries[key] = cacheEntry{value: value, expires: time.Now().Add(r.ttl)}
+}
+
+// invalidate drops every entry.
+func (r *responseCache) invalidate() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	r.entries = map[string]cacheEntry{}
+}
@@ -0,0 +1,108 @@
+package pinecone
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+// redirectTransport sends every request to a test server, regardless of the
+// controller host it was addressed to.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestController(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	target, _ := url.Parse(srv.URL)
+	c := NewClient("key", "test")
+	c.HTTPClient = &http.Client{Transport: redirectTransport{target: target}}
+	return c
+}
+
+func TestDescribeIndexIsCachedUntilMutation(t *testing.T) {
+	var describes int64
+	c := newTestController(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/databases/ready":
+			atomic.AddInt64(&describes, 1)
+			w.Write([]byte(`{"database":{"name":"ready"},"status":{"ready":true,"state":"Ready"}}`))
+		case r.Method == "GET" && r.URL.Path == "/databases/initializing":
+			atomic.AddInt64(&describes, 1)
+			w.Write([]byte(`{"database":{"name":"initializing"},"status":{"ready":false,"state":"Initializing"}}`))
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.DescribeIndex("ready"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if describes != 1 {
+		t.Errorf("expected a ready index to be described once, got %d", describes)
+	}
+
+	// indexes that are not ready yet are polled, and must not be cached
+	for i := 0; i < 2; i++ {
+		if _, err := c.DescribeIndex("initializing"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if describes != 3 {
+		t.Errorf("expected an initializing index to be described every time, got %d", describes)
+	}
+
+	if _, err := c.DeleteIndex("other"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.DescribeIndex("ready"); err != nil {
+		t.Fatal(err)
+	}
+	if describes != 4 {
+		t.Errorf("expected a mutating call to invalidate the cache, got %d describes", describes)
+	}
+}
+
+func TestListIndexesIsCached(t *testing.T) {
+	var lists int64
+	c := newTestController(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&lists, 1)
+		w.Write([]byte(`["a","b"]`))
+	})
+
+	for i := 0; i < 3; i++ {
+		names, err := c.ListIndexes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(names) != 2 {
+			t.Fatalf("unexpected names %v", names)
+		}
+		// callers may modify the returned slice without affecting the cache
+		names[0] = "modified"
+	}
+	if lists != 1 {
+		t.Errorf("expected a single list request, got %d", lists)
+	}
+
+	names, _ := c.ListIndexes()
+	if names[0] != "a" {
+		t.Errorf("expected the cached response to be unchanged, got %v", names)
+	}
+}
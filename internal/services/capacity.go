@@ -0,0 +1,129 @@
+package pinecone
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Published capacities are for vectors of this dimension without metadata.
+const referenceDimension = 768
+
+// podCapacity is the published capacity of an x1 pod. Each size step, ie.
+// x2, x4 and x8, doubles the number of vectors but not the throughput.
+type podCapacity struct {
+	// vectors of referenceDimension that fit on an x1 pod
+	vectors int64
+	// queries per second served by each replica
+	qps float64
+}
+
+// See https://docs.pinecone.io/docs/indexes#pods-pod-types-and-pod-sizes
+var podCapacities = map[string]podCapacity{
+	"s1": {vectors: 5_000_000, qps: 10},
+	"p1": {vectors: 1_000_000, qps: 30},
+	"p2": {vectors: 1_100_000, qps: 150},
+}
+
+var podSizes = map[string]int64{"x1": 1, "x2": 2, "x4": 4, "x8": 8}
+
+// CapacityRequest describes the workload an index is sized for.
+type CapacityRequest struct {
+	VectorCount int64
+	Dimension   int64
+	// Average metadata per vector, in bytes
+	MetadataSize int64
+	// Queries per second the index must serve
+	QPS float64
+	// When set, only this pod type, ex. p1.x2, or pod family, ex. s1, is considered.
+	PodType string
+}
+
+// CapacityPlan is a pod configuration for a CapacityRequest.
+type CapacityPlan struct {
+	PodType  string
+	Pods     int64
+	Replicas int64
+	Shards   int64
+	// Vectors that fit on a single pod, ie. shard
+	VectorsPerPod int64
+	// Zero if the pod type has no price
+	EstimatedMonthlyCost float64
+}
+
+// PlanCapacity returns the cheapest pod configuration that fits the vectors
+// and serves the requested QPS, according to Pinecone's published per-pod
+// capacities. Ties go to the configuration with the fewest pods.
+func PlanCapacity(req CapacityRequest, prices *PriceTable) (*CapacityPlan, error) {
+	if req.VectorCount < 0 || req.Dimension < 1 || req.MetadataSize < 0 || req.QPS < 0 {
+		return nil, fmt.Errorf("vector count, metadata size and QPS must not be negative, and dimension must be at least 1")
+	}
+
+	// bytes per vector, relative to the published capacities
+	bytesPerVector := float64(req.Dimension*4 + req.MetadataSize)
+	referenceBytes := float64(referenceDimension * 4)
+
+	var plans []CapacityPlan
+	for family, capacity := range podCapacities {
+		for size, multiplier := range podSizes {
+			podType := family + "." + size
+			if req.PodType != "" && req.PodType != podType && req.PodType != family {
+				continue
+			}
+
+			vectorsPerPod := int64(float64(capacity.vectors*multiplier) * referenceBytes / bytesPerVector)
+			if vectorsPerPod < 1 {
+				continue
+			}
+
+			shards := int64(math.Ceil(float64(req.VectorCount) / float64(vectorsPerPod)))
+			if shards < 1 {
+				shards = 1
+			}
+			replicas := int64(math.Ceil(req.QPS / capacity.qps))
+			if replicas < 1 {
+				replicas = 1
+			}
+
+			plan := CapacityPlan{
+				PodType:       podType,
+				Pods:          shards * replicas,
+				Replicas:      replicas,
+				Shards:        shards,
+				VectorsPerPod: vectorsPerPod,
+			}
+			if cost, err := prices.MonthlyCost(podType, plan.Pods); err == nil {
+				plan.EstimatedMonthlyCost = cost
+			} else {
+				plan.EstimatedMonthlyCost = math.Inf(1)
+			}
+			plans = append(plans, plan)
+		}
+	}
+
+	if len(plans) == 0 {
+		known := []string{}
+		for family := range podCapacities {
+			known = append(known, family)
+		}
+		sort.Strings(known)
+		return nil, fmt.Errorf("no published capacity for pod type %q, expected one of %s, optionally with a size, ex. p1.x2", req.PodType, strings.Join(known, ", "))
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		if plans[i].EstimatedMonthlyCost != plans[j].EstimatedMonthlyCost {
+			return plans[i].EstimatedMonthlyCost < plans[j].EstimatedMonthlyCost
+		}
+		if plans[i].Pods != plans[j].Pods {
+			return plans[i].Pods < plans[j].Pods
+		}
+		return plans[i].PodType < plans[j].PodType
+	})
+
+	best := plans[0]
+	if math.IsInf(best.EstimatedMonthlyCost, 1) {
+		best.EstimatedMonthlyCost = 0
+	}
+	return &best, nil
+}
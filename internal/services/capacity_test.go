@@ -0,0 +1,72 @@
+package pinecone
+
+import "testing"
+
+func TestPlanCapacity(t *testing.T) {
+	prices := DefaultPriceTable()
+
+	tests := []struct {
+		name    string
+		req     CapacityRequest
+		want    CapacityPlan
+		wantErr bool
+	}{
+		{
+			name: "small index",
+			req:  CapacityRequest{VectorCount: 100_000, Dimension: 768},
+			want: CapacityPlan{PodType: "p1.x1", Pods: 1, Replicas: 1, Shards: 1},
+		},
+		{
+			name: "storage bound",
+			req:  CapacityRequest{VectorCount: 20_000_000, Dimension: 768},
+			want: CapacityPlan{PodType: "s1.x4", Pods: 1, Replicas: 1, Shards: 1},
+		},
+		{
+			name: "larger dimension and metadata",
+			req:  CapacityRequest{VectorCount: 20_000_000, Dimension: 1536, MetadataSize: 1024, PodType: "s1"},
+			want: CapacityPlan{PodType: "s1.x2", Pods: 5, Replicas: 1, Shards: 5},
+		},
+		{
+			name: "throughput bound",
+			req:  CapacityRequest{VectorCount: 500_000, Dimension: 768, QPS: 100, PodType: "p1.x1"},
+			want: CapacityPlan{PodType: "p1.x1", Pods: 4, Replicas: 4, Shards: 1},
+		},
+		{
+			name: "sharded and replicated",
+			req:  CapacityRequest{VectorCount: 3_000_000, Dimension: 768, QPS: 50, PodType: "p1.x1"},
+			want: CapacityPlan{PodType: "p1.x1", Pods: 6, Replicas: 2, Shards: 3},
+		},
+		{
+			name:    "unknown pod type",
+			req:     CapacityRequest{VectorCount: 1, Dimension: 8, PodType: "q1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid dimension",
+			req:     CapacityRequest{VectorCount: 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PlanCapacity(tt.req, prices)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.PodType != tt.want.PodType || got.Pods != tt.want.Pods || got.Replicas != tt.want.Replicas || got.Shards != tt.want.Shards {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+			if got.EstimatedMonthlyCost <= 0 {
+				t.Errorf("expected a cost estimate, got %v", got.EstimatedMonthlyCost)
+			}
+		})
+	}
+}
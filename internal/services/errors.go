@@ -0,0 +1,26 @@
+package pinecone
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned when Pinecone responds with a non-2xx status code.
+type APIError struct {
+	// The client operation, ex. DescribeIndex
+	Operation  string
+	StatusCode int
+	// The response body
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s failed with status code %d and message %q", e.Operation, e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is a 404 from Pinecone.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,324 @@
+package pinecone
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	vectorservice "github.com/thiskevinwang/terraform-provider-pinecone/internal/services/vectorservice"
+)
+
+// GrpcConns holds one connection per index host so that data plane calls made
+// through the client reuse the same connection.
+type GrpcConns struct {
+	mu      sync.Mutex
+	conns   map[string]*grpc.ClientConn
+	options []grpc.DialOption
+}
+
+// NewGrpcConns creates an empty connection pool. Without options, connections
+// are dialed with TLS on port 443 of the index host.
+func NewGrpcConns(options ...grpc.DialOption) *GrpcConns {
+	if len(options) == 0 {
+		options = []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})),
+		}
+	}
+	return &GrpcConns{
+		conns:   map[string]*grpc.ClientConn{},
+		options: options,
+	}
+}
+
+// get returns the connection for host, dialing it on first use.
+func (g *GrpcConns) get(host string) (vectorservice.VectorServiceClient, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	conn, ok := g.conns[host]
+	if !ok {
+		var err error
+		conn, err = grpc.Dial(host+":443", g.options...)
+		if err != nil {
+			return nil, err
+		}
+		g.conns[host] = conn
+	}
+
+	return vectorservice.NewVectorServiceClient(conn), nil
+}
+
+// Close closes every pooled connection.
+func (g *GrpcConns) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var firstErr error
+	for host, conn := range g.conns {
+		if err := conn.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(g.conns, host)
+	}
+	return firstErr
+}
+
+// grpcContext attaches the credentials the same way the REST transport sends
+// them, and waits on the client's limiter so both transports share the same
+// budget. The returned func must be called once the call completes.
+func (c *Client) grpcContext() (context.Context, func(), error) {
+	headers, err := c.credentials()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// metadata keys are lowercased, ex. api-key
+	ctx := metadata.AppendToOutgoingContext(context.Background(), headers...)
+	release, err := c.Limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ctx, release, nil
+}
+
+// grpcError formats a gRPC failure like its REST counterpart, using the HTTP
+// status code the REST gateway would have returned.
+func grpcError(operation string, err error) error {
+	st, _ := status.FromError(err)
+	return &APIError{Operation: operation, StatusCode: httpStatusFromCode(st.Code()), Message: st.Message()}
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func toGrpcSparseValues(s *SparseValues) *vectorservice.SparseValues {
+	if s == nil {
+		return nil
+	}
+	return &vectorservice.SparseValues{Indices: s.Indices, Values: s.Values}
+}
+
+func fromGrpcSparseValues(s *vectorservice.SparseValues) *SparseValues {
+	if s == nil {
+		return nil
+	}
+	return &SparseValues{Indices: s.Indices, Values: s.Values}
+}
+
+func toGrpcStruct(m map[string]interface{}) (*structpb.Struct, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return structpb.NewStruct(m)
+}
+
+func fromGrpcStruct(s *structpb.Struct) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
+
+func (c *Client) upsertGrpc(host string, data UpsertRequest) (*UpsertResponse, error) {
+	client, err := c.GrpcConns.get(host)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &vectorservice.UpsertRequest{Namespace: data.Namespace}
+	for _, v := range data.Vectors {
+		md, err := toGrpcStruct(v.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		req.Vectors = append(req.Vectors, &vectorservice.Vector{
+			Id:           v.Id,
+			Values:       v.Values,
+			SparseValues: toGrpcSparseValues(v.SparseValues),
+			Metadata:     md,
+		})
+	}
+
+	ctx, release, err := c.grpcContext()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := client.Upsert(ctx, req)
+	if err != nil {
+		return nil, grpcError("Upsert", err)
+	}
+
+	return &UpsertResponse{UpsertedCount: int64(res.UpsertedCount)}, nil
+}
+
+func (c *Client) queryGrpc(host string, data QueryRequest) (*QueryResponse, error) {
+	client, err := c.GrpcConns.get(host)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := toGrpcStruct(data.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, release, err := c.grpcContext()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := client.Query(ctx, &vectorservice.QueryRequest{
+		Namespace:       data.Namespace,
+		TopK:            uint32(data.TopK),
+		Filter:          filter,
+		IncludeValues:   data.IncludeValues,
+		IncludeMetadata: data.IncludeMetadata,
+		Vector:          data.Vector,
+		SparseVector:    toGrpcSparseValues(data.SparseVector),
+		Id:              data.Id,
+	})
+	if err != nil {
+		return nil, grpcError("Query", err)
+	}
+
+	queryResponse := &QueryResponse{Namespace: res.Namespace}
+	for _, m := range res.Matches {
+		queryResponse.Matches = append(queryResponse.Matches, QueryMatch{
+			Id:           m.Id,
+			Score:        m.Score,
+			Values:       m.Values,
+			SparseValues: fromGrpcSparseValues(m.SparseValues),
+			Metadata:     fromGrpcStruct(m.Metadata),
+		})
+	}
+	return queryResponse, nil
+}
+
+func (c *Client) fetchGrpc(host string, namespace string, ids []string) (*FetchResponse, error) {
+	client, err := c.GrpcConns.get(host)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, release, err := c.grpcContext()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := client.Fetch(ctx, &vectorservice.FetchRequest{
+		Ids:       ids,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, grpcError("Fetch", err)
+	}
+
+	fetchResponse := &FetchResponse{
+		Vectors:   map[string]Vector{},
+		Namespace: res.Namespace,
+	}
+	for id, v := range res.Vectors {
+		fetchResponse.Vectors[id] = Vector{
+			Id:           v.Id,
+			Values:       v.Values,
+			SparseValues: fromGrpcSparseValues(v.SparseValues),
+			Metadata:     fromGrpcStruct(v.Metadata),
+		}
+	}
+	return fetchResponse, nil
+}
+
+func (c *Client) deleteVectorsGrpc(host string, data DeleteVectorsRequest) error {
+	client, err := c.GrpcConns.get(host)
+	if err != nil {
+		return err
+	}
+
+	ctx, release, err := c.grpcContext()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	_, err = client.Delete(ctx, &vectorservice.DeleteRequest{
+		Ids:       data.Ids,
+		DeleteAll: data.DeleteAll,
+		Namespace: data.Namespace,
+	})
+	if err != nil {
+		return grpcError("DeleteVectors", err)
+	}
+	return nil
+}
+
+func (c *Client) describeIndexStatsGrpc(host string, data DescribeIndexStatsRequest) (*DescribeIndexStatsResponse, error) {
+	client, err := c.GrpcConns.get(host)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := toGrpcStruct(data.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, release, err := c.grpcContext()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := client.DescribeIndexStats(ctx, &vectorservice.DescribeIndexStatsRequest{
+		Filter: filter,
+	})
+	if err != nil {
+		return nil, grpcError("DescribeIndexStats", err)
+	}
+
+	statsResponse := &DescribeIndexStatsResponse{
+		Namespaces:       map[string]NamespaceSummary{},
+		Dimension:        int64(res.Dimension),
+		IndexFullness:    res.IndexFullness,
+		TotalVectorCount: int64(res.TotalVectorCount),
+	}
+	for name, summary := range res.Namespaces {
+		statsResponse.Namespaces[name] = NamespaceSummary{VectorCount: int64(summary.VectorCount)}
+	}
+	return statsResponse, nil
+}
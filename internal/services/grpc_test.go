@@ -0,0 +1,137 @@
+package pinecone
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	vectorservice "github.com/thiskevinwang/terraform-provider-pinecone/internal/services/vectorservice"
+)
+
+// fakeVectorService is an in-process stand-in for an index host.
+type fakeVectorService struct {
+	vectorservice.UnimplementedVectorServiceServer
+	upserted []*vectorservice.Vector
+	apiKeys  []string
+}
+
+func (f *fakeVectorService) Upsert(ctx context.Context, req *vectorservice.UpsertRequest) (*vectorservice.UpsertResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	f.apiKeys = append(f.apiKeys, md.Get("api-key")...)
+	f.upserted = append(f.upserted, req.Vectors...)
+	return &vectorservice.UpsertResponse{UpsertedCount: uint32(len(req.Vectors))}, nil
+}
+
+func (f *fakeVectorService) Query(ctx context.Context, req *vectorservice.QueryRequest) (*vectorservice.QueryResponse, error) {
+	return &vectorservice.QueryResponse{
+		Namespace: req.Namespace,
+		Matches: []*vectorservice.ScoredVector{
+			{Id: "a", Score: 0.5, SparseValues: req.SparseVector},
+		},
+	}, nil
+}
+
+func (f *fakeVectorService) Fetch(ctx context.Context, req *vectorservice.FetchRequest) (*vectorservice.FetchResponse, error) {
+	return nil, status.Error(codes.NotFound, "namespace not found")
+}
+
+func newFakeGrpcClient(t *testing.T) (*Client, *fakeVectorService) {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	fake := &fakeVectorService{}
+	vectorservice.RegisterVectorServiceServer(srv, fake)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conns := NewGrpcConns(
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	t.Cleanup(func() { conns.Close() })
+
+	c := NewClient("key", "test")
+	c.GrpcConns = conns
+	return c, fake
+}
+
+func TestGrpcUpsert(t *testing.T) {
+	c, fake := newFakeGrpcClient(t)
+
+	for i := 0; i < 2; i++ {
+		res, err := c.Upsert("idx.svc.test", UpsertRequest{
+			Vectors: []Vector{{
+				Id:           "a",
+				Values:       []float32{0.1},
+				SparseValues: &SparseValues{Indices: []uint32{3}, Values: []float32{0.2}},
+				Metadata:     map[string]interface{}{"genre": "drama"},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.UpsertedCount != 1 {
+			t.Errorf("expected 1 upserted, got %d", res.UpsertedCount)
+		}
+	}
+
+	if len(c.GrpcConns.conns) != 1 {
+		t.Errorf("expected the connection to be reused, got %d connections", len(c.GrpcConns.conns))
+	}
+	if len(fake.apiKeys) != 2 || fake.apiKeys[0] != "key" {
+		t.Errorf("expected api-key metadata on each call, got %v", fake.apiKeys)
+	}
+	if got := fake.upserted[0]; got.SparseValues.Indices[0] != 3 || got.Metadata.Fields["genre"].GetStringValue() != "drama" {
+		t.Errorf("unexpected upserted vector %v", got)
+	}
+}
+
+func TestGrpcQuerySparseVector(t *testing.T) {
+	c, _ := newFakeGrpcClient(t)
+
+	res, err := c.Query("idx.svc.test", QueryRequest{
+		TopK:         1,
+		Vector:       []float32{0.1},
+		SparseVector: &SparseValues{Indices: []uint32{9}, Values: []float32{0.4}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Matches) != 1 || res.Matches[0].SparseValues.Indices[0] != 9 {
+		t.Errorf("unexpected matches %+v", res.Matches)
+	}
+}
+
+func TestGrpcErrorMatchesRest(t *testing.T) {
+	c, _ := newFakeGrpcClient(t)
+
+	_, err := c.Fetch("idx.svc.test", "missing", []string{"a"})
+	if err == nil || !strings.Contains(err.Error(), "Fetch failed with status code 404") {
+		t.Fatalf("expected a REST style 404 error, got %v", err)
+	}
+}
+
+func TestGrpcWithoutApiKey(t *testing.T) {
+	c, fake := newFakeGrpcClient(t)
+	c.apiKey = ""
+
+	_, err := c.Upsert("idx.svc.test", UpsertRequest{Vectors: []Vector{{Id: "a", Values: []float32{0.1}}}})
+	if !errors.Is(err, ErrNoApiKey) {
+		t.Fatalf("expected ErrNoApiKey, got %v", err)
+	}
+	if len(fake.upserted) != 0 {
+		t.Errorf("expected no unauthenticated call, got %v", fake.upserted)
+	}
+}
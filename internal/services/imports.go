@@ -0,0 +1,242 @@
+package pinecone
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ImportPlane is the set of bulk import operations. Like the other data plane
+// operations, they are sent to the index host. It is satisfied by *Client,
+// and by fakes in unit tests.
+type ImportPlane interface {
+	StartImport(host string, data StartImportRequest) (*StartImportResponse, error)
+	ListImports(host string) ([]Import, error)
+	DescribeImport(host string, id string) (*Import, error)
+	CancelImport(host string, id string) error
+}
+
+var _ ImportPlane = &Client{}
+
+// newImportRequest initializes an authenticated bulk import request, which
+// requires the api version header.
+func (c *Client) newImportRequest(method string, host string, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, fmt.Sprintf(dataPlaneUrl, host)+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("X-Pinecone-Api-Version", apiVersion)
+	if body != nil {
+		req.Header.Add("content-type", "application/json")
+	}
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type ImportErrorMode struct {
+	// values: abort, continue
+	OnError string `json:"onError"`
+}
+
+type StartImportRequest struct {
+	// The s3:// or gs:// URI of the bucket prefix holding the Parquet files to import.
+	Uri string `json:"uri"`
+	// The id of the storage integration that grants access to the bucket.
+	IntegrationId string           `json:"integrationId,omitempty"`
+	ErrorMode     *ImportErrorMode `json:"errorMode,omitempty"`
+}
+
+type StartImportResponse struct {
+	Id string `json:"id"`
+}
+
+type Import struct {
+	Id  string `json:"id"`
+	Uri string `json:"uri"`
+	// values: Pending, InProgress, Failed, Completed, Cancelled
+	Status          string  `json:"status"`
+	PercentComplete float64 `json:"percentComplete"`
+	RecordsImported int64   `json:"recordsImported"`
+	// Why the import failed, when Status is Failed.
+	Error string `json:"error"`
+	// RFC 3339 timestamps
+	CreatedAt  string `json:"createdAt"`
+	FinishedAt string `json:"finishedAt"`
+}
+
+type listImportsResponse struct {
+	Data       []Import    `json:"data"`
+	Pagination *pagination `json:"pagination"`
+}
+
+// start_import
+// POST
+// https://{index_host}/bulk/imports
+// This operation starts importing records from object storage into a
+// serverless index. The import runs after the operation returns.
+//
+// 200 JSON - The id of the import
+// 400 JSON - Bad request, ex. the URI is not a supported bucket.
+// 409 JSON - Too many imports are already running.
+func (c *Client) StartImport(host string, data StartImportRequest) (*StartImportResponse, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newImportRequest("POST", host, "/bulk/imports", bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		startResponse := &StartImportResponse{}
+		err := json.Unmarshal(body, startResponse)
+		if err != nil {
+			return nil, err
+		}
+		return startResponse, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "StartImport", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// list_imports
+// GET
+// https://{index_host}/bulk/imports
+// This operation returns the imports of an index. Every page of results is
+// fetched.
+//
+// 200 JSON - A page of imports
+func (c *Client) ListImports(host string) ([]Import, error) {
+	imports := []Import{}
+	next := ""
+	for {
+		path := "/bulk/imports"
+		if next != "" {
+			path += "?paginationToken=" + url.QueryEscape(next)
+		}
+
+		req, err := c.newImportRequest("GET", host, path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := c.do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode >= 300 { // non-2xx
+			return nil, &APIError{Operation: "ListImports", StatusCode: res.StatusCode, Message: string(body)}
+		}
+
+		page := &listImportsResponse{}
+		err = json.Unmarshal(body, page)
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, page.Data...)
+
+		if page.Pagination == nil || page.Pagination.Next == "" {
+			return imports, nil
+		}
+		next = page.Pagination.Next
+	}
+}
+
+// describe_import
+// GET
+// https://{index_host}/bulk/imports/{id}
+// This operation returns an import and its progress.
+//
+// 200 JSON - The import
+// 404 JSON - Import not found.
+func (c *Client) DescribeImport(host string, id string) (*Import, error) {
+	req, err := c.newImportRequest("GET", host, fmt.Sprintf("/bulk/imports/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		imp := &Import{}
+		err := json.Unmarshal(body, imp)
+		if err != nil {
+			return nil, err
+		}
+		return imp, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "DescribeImport", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// cancel_import
+// DELETE
+// https://{index_host}/bulk/imports/{id}
+// This operation cancels an import that is Pending or InProgress. Records
+// that were already imported are kept.
+//
+// 200 - The import was cancelled
+// 404 JSON - Import not found.
+func (c *Client) CancelImport(host string, id string) error {
+	req, err := c.newImportRequest("DELETE", host, fmt.Sprintf("/bulk/imports/%s", id), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		return nil
+	default: // non-2xx
+		return &APIError{Operation: "CancelImport", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
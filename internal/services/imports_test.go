@@ -0,0 +1,54 @@
+package pinecone
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStartAndDescribeImport(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Pinecone-Api-Version") != apiVersion {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "POST /bulk/imports":
+			var got StartImportRequest
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &got)
+			if got.Uri != "s3://bucket/movies/" || got.ErrorMode == nil || got.ErrorMode.OnError != "abort" {
+				t.Errorf("unexpected request %s", body)
+			}
+			w.Write([]byte(`{"id":"101"}`))
+		case "GET /bulk/imports/101":
+			w.Write([]byte(`{"id":"101","uri":"s3://bucket/movies/","status":"InProgress","percentComplete":42.5,"recordsImported":1000}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.HTTPClient = srv.Client()
+	host := strings.TrimPrefix(srv.URL, "https://")
+
+	started, err := c.StartImport(host, StartImportRequest{Uri: "s3://bucket/movies/", ErrorMode: &ImportErrorMode{OnError: "abort"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imp, err := c.DescribeImport(host, started.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imp.Status != "InProgress" || imp.PercentComplete != 42.5 || imp.RecordsImported != 1000 {
+		t.Errorf("unexpected import %+v", imp)
+	}
+
+	if _, err := c.DescribeImport(host, "missing"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
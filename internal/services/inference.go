@@ -0,0 +1,197 @@
+package pinecone
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// InferencePlane is the set of inference operations, which run hosted models
+// on the global control plane. It is satisfied by *Client, and by fakes in
+// unit tests.
+type InferencePlane interface {
+	Embed(data EmbedRequest) (*EmbedResponse, error)
+	ListModels(modelType string) ([]Model, error)
+	DescribeModel(name string) (*Model, error)
+}
+
+var _ InferencePlane = &Client{}
+
+type EmbedInput struct {
+	Text string `json:"text"`
+}
+
+type EmbedParameters struct {
+	// values: query, passage
+	InputType string `json:"input_type,omitempty"`
+	// What to do with inputs longer than the model's maximum sequence length. values: END, NONE
+	Truncate string `json:"truncate,omitempty"`
+}
+
+type EmbedRequest struct {
+	// The hosted embedding model, ex. multilingual-e5-large
+	Model      string          `json:"model"`
+	Parameters EmbedParameters `json:"parameters"`
+	Inputs     []EmbedInput    `json:"inputs"`
+}
+
+type Embedding struct {
+	// values: dense, sparse
+	VectorType string    `json:"vector_type"`
+	Values     []float64 `json:"values"`
+}
+
+type EmbedResponse struct {
+	Model string `json:"model"`
+	// One embedding per input, in the same order.
+	Data  []Embedding `json:"data"`
+	Usage struct {
+		TotalTokens int64 `json:"total_tokens"`
+	} `json:"usage"`
+}
+
+// embed
+// POST
+// https://api.pinecone.io/embed
+// This operation generates embeddings of the inputs with a hosted model.
+//
+// 200 JSON - The embeddings
+// 400 JSON - Bad request, ex. an unknown model or too many inputs.
+func (c *Client) Embed(data EmbedRequest) (*EmbedResponse, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newApiRequest("POST", "/embed", bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		embedResponse := &EmbedResponse{}
+		err := json.Unmarshal(body, embedResponse)
+		if err != nil {
+			return nil, err
+		}
+		return embedResponse, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "Embed", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// Model is a hosted inference model.
+type Model struct {
+	Name             string `json:"model"`
+	ShortDescription string `json:"short_description"`
+	// values: embed, rerank
+	Type string `json:"type"`
+	// values: dense, sparse. Empty for rerank models.
+	VectorType string `json:"vector_type"`
+	// The dimension of the embeddings, unless another of SupportedDimensions is requested.
+	DefaultDimension    int64    `json:"default_dimension"`
+	SupportedDimensions []int64  `json:"supported_dimensions"`
+	SupportedMetrics    []string `json:"supported_metrics"`
+	Modality            string   `json:"modality"`
+	// The maximum number of tokens of an input.
+	MaxSequenceLength int64  `json:"max_sequence_length"`
+	MaxBatchSize      int64  `json:"max_batch_size"`
+	ProviderName      string `json:"provider_name"`
+}
+
+type listModelsResponse struct {
+	Models []Model `json:"models"`
+}
+
+// list_models
+// GET
+// https://api.pinecone.io/models
+// This operation returns the hosted models, or the models of modelType
+// (embed or rerank) when it is not empty.
+//
+// 200 JSON - The models
+func (c *Client) ListModels(modelType string) ([]Model, error) {
+	path := "/models"
+	if modelType != "" {
+		path += "?type=" + url.QueryEscape(modelType)
+	}
+
+	req, err := c.newApiRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		listResponse := &listModelsResponse{}
+		err := json.Unmarshal(body, listResponse)
+		if err != nil {
+			return nil, err
+		}
+		return listResponse.Models, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "ListModels", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// describe_model
+// GET
+// https://api.pinecone.io/models/{model_name}
+// This operation returns a hosted model.
+//
+// 200 JSON - The model
+// 404 JSON - Model not found.
+func (c *Client) DescribeModel(name string) (*Model, error) {
+	req, err := c.newApiRequest("GET", fmt.Sprintf("/models/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		model := &Model{}
+		err := json.Unmarshal(body, model)
+		if err != nil {
+			return nil, err
+		}
+		return model, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "DescribeModel", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
@@ -0,0 +1,80 @@
+package pinecone
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEmbed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/embed" || r.Header.Get("X-Pinecone-Api-Version") != apiVersion {
+			t.Errorf("unexpected request %s %s %v", r.Method, r.URL.Path, r.Header)
+		}
+		var got EmbedRequest
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &got)
+		if got.Model != "multilingual-e5-large" || got.Parameters.InputType != "query" || len(got.Inputs) != 2 || got.Inputs[1].Text != "world" {
+			t.Errorf("unexpected request %s", body)
+		}
+		w.Write([]byte(`{"model":"multilingual-e5-large","data":[{"vector_type":"dense","values":[0.1,0.2]},{"vector_type":"dense","values":[0.3,0.4]}],"usage":{"total_tokens":6}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.apiUrl = srv.URL
+
+	res, err := c.Embed(EmbedRequest{
+		Model:      "multilingual-e5-large",
+		Parameters: EmbedParameters{InputType: "query"},
+		Inputs:     []EmbedInput{{Text: "hello"}, {Text: "world"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Data) != 2 || res.Data[1].Values[1] != 0.4 || res.Usage.TotalTokens != 6 {
+		t.Errorf("unexpected response %+v", res)
+	}
+}
+
+func TestListAndDescribeModels(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/models":
+			if r.URL.Query().Get("type") != "embed" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"models":[{"model":"multilingual-e5-large","type":"embed","vector_type":"dense","default_dimension":1024,"supported_dimensions":[1024],"supported_metrics":["cosine","euclidean"],"max_sequence_length":507}]}`))
+		case "/models/multilingual-e5-large":
+			w.Write([]byte(`{"model":"multilingual-e5-large","type":"embed","vector_type":"dense","default_dimension":1024}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.apiUrl = srv.URL
+
+	models, err := c.ListModels("embed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(models) != 1 || models[0].DefaultDimension != 1024 || models[0].SupportedMetrics[1] != "euclidean" || models[0].MaxSequenceLength != 507 {
+		t.Errorf("unexpected models %+v", models)
+	}
+
+	model, err := c.DescribeModel("multilingual-e5-large")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if model.Name != "multilingual-e5-large" || model.DefaultDimension != 1024 {
+		t.Errorf("unexpected model %+v", model)
+	}
+
+	if _, err := c.DescribeModel("missing"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
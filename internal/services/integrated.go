@@ -0,0 +1,90 @@
+package pinecone
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// EmbedPlane is the set of global control plane operations on indexes with
+// integrated embedding, ie. indexes bound to a hosted embedding model. It is
+// satisfied by *Client, and by fakes in unit tests.
+type EmbedPlane interface {
+	CreateIndexForModel(data CreateIndexForModelRequest) (*IndexModel, error)
+}
+
+var _ EmbedPlane = &Client{}
+
+// IndexEmbed is the embedding configuration of an index. Dimension and
+// VectorType are reported by the service from the model.
+type IndexEmbed struct {
+	// The hosted embedding model, ex. multilingual-e5-large
+	Model string `json:"model,omitempty"`
+	// The distance metric, which must be supported by the model. Defaults to the model's default metric.
+	Metric     string `json:"metric,omitempty"`
+	Dimension  int64  `json:"dimension,omitempty"`
+	VectorType string `json:"vector_type,omitempty"`
+	// Maps the model's input field, ex. text, to the record field that is embedded.
+	FieldMap map[string]string `json:"field_map,omitempty"`
+	// Parameters passed to the model when embedding queries and records, ex. truncate.
+	ReadParameters  map[string]interface{} `json:"read_parameters,omitempty"`
+	WriteParameters map[string]interface{} `json:"write_parameters,omitempty"`
+}
+
+type CreateIndexForModelRequest struct {
+	// The name of the index to be created. The maximum length is 45 characters.
+	Name string `json:"name"`
+	// values: aws, gcp, azure
+	Cloud  string     `json:"cloud"`
+	Region string     `json:"region"`
+	Embed  IndexEmbed `json:"embed"`
+	// values: enabled, disabled
+	DeletionProtection string            `json:"deletion_protection,omitempty"`
+	Tags               map[string]string `json:"tags,omitempty"`
+}
+
+// create_for_model
+// POST
+// https://api.pinecone.io/indexes/create-for-model
+// This operation creates a serverless index bound to a hosted embedding model.
+// The dimension and metric of the index are taken from the model.
+//
+// 201 JSON - The index, which is Initializing
+// 400 JSON - Bad request, ex. an unknown model or an unsupported metric.
+// 409 JSON - An index with the name already exists.
+func (c *Client) CreateIndexForModel(data CreateIndexForModelRequest) (*IndexModel, error) {
+	defer c.cache.invalidate()
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newApiRequest("POST", "/indexes/create-for-model", bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		index := &IndexModel{}
+		err := json.Unmarshal(body, index)
+		if err != nil {
+			return nil, err
+		}
+		return index, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "CreateIndexForModel", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
@@ -0,0 +1,46 @@
+package pinecone
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateIndexForModel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/indexes/create-for-model" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var got map[string]interface{}
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &got)
+		embed, _ := got["embed"].(map[string]interface{})
+		if got["cloud"] != "aws" || embed["model"] != "multilingual-e5-large" || embed["field_map"].(map[string]interface{})["text"] != "chunk_text" {
+			t.Errorf("unexpected request %s", body)
+		}
+		if _, ok := embed["dimension"]; ok {
+			t.Errorf("expected the dimension to be left to the model, got %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"name":"movies","metric":"cosine","dimension":1024,"embed":{"model":"multilingual-e5-large","metric":"cosine","dimension":1024,"field_map":{"text":"chunk_text"}},"status":{"ready":false,"state":"Initializing"}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.apiUrl = srv.URL
+
+	index, err := c.CreateIndexForModel(CreateIndexForModelRequest{
+		Name:   "movies",
+		Cloud:  "aws",
+		Region: "us-east-1",
+		Embed:  IndexEmbed{Model: "multilingual-e5-large", FieldMap: map[string]string{"text": "chunk_text"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index.Dimension != 1024 || index.Metric != "cosine" || index.Embed == nil || index.Embed.Model != "multilingual-e5-large" {
+		t.Errorf("unexpected index %+v", index)
+	}
+}
@@ -0,0 +1,103 @@
+package pinecone
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// Limiter bounds the request rate and the number of in-flight requests made
+// by the client. Terraform runs resource operations in parallel, so without
+// it a large apply can trip the controller's 429s.
+type Limiter struct {
+	rate *rate.Limiter
+	sem  chan struct{}
+}
+
+// NewLimiter creates a limiter. A requestsPerSecond or maxConcurrentRequests
+// of zero leaves that dimension unbounded.
+func NewLimiter(requestsPerSecond float64, maxConcurrentRequests int64) *Limiter {
+	l := &Limiter{}
+	if requestsPerSecond > 0 {
+		// allow a burst of one second worth of requests, but at least one
+		burst := int(requestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		l.rate = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	if maxConcurrentRequests > 0 {
+		l.sem = make(chan struct{}, maxConcurrentRequests)
+	}
+	return l
+}
+
+// acquire blocks until a request may be sent. The returned func must be
+// called once the request has completed. A nil Limiter never blocks.
+func (l *Limiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.sem != nil {
+			<-l.sem
+		}
+	}
+
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// releaseOnClose holds a concurrency slot until the response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	if r.release != nil {
+		r.release()
+		r.release = nil
+	}
+	return err
+}
+
+// do sends a request through the client's limiter.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	release, err := c.Limiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	res.Body = &releaseOnClose{ReadCloser: res.Body, release: release}
+	return res, nil
+}
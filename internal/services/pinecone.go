@@ -3,26 +3,271 @@ package pinecone
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 )
 
-type Pinecone struct {
-	ApiKey      string
-	Environment string
+// ControlPlane is the set of controller operations that resources and data
+// sources depend on. It is satisfied by *Client, and by fakes in unit tests.
+type ControlPlane interface {
+	Environment() string
+	Whoami() (*WhoamiResponse, error)
+	ListCollections() ([]string, error)
+	CreateCollection(bodyParams CreateCollectionBodyParams) (*string, error)
+	DescribeCollection(name string) (*DescribeCollectionResponse, error)
+	DeleteCollection(name string) (*string, error)
+	ListIndexes() ([]string, error)
+	CreateIndex(data CreateIndexBodyParams) (*string, error)
+	DescribeIndex(name string) (*DescribeIndexResponse, error)
+	ConfigureIndex(name string, data *ConfigureIndexRequest) (*string, error)
+	DeleteIndex(name string) (*string, error)
+}
+
+// DataPlane is the set of vector operations sent to an index host.
+type DataPlane interface {
+	Upsert(host string, data UpsertRequest) (*UpsertResponse, error)
+	Query(host string, data QueryRequest) (*QueryResponse, error)
+	Fetch(host string, namespace string, ids []string) (*FetchResponse, error)
+	DeleteVectors(host string, data DeleteVectorsRequest) error
+	DescribeIndexStats(host string, data DescribeIndexStatsRequest) (*DescribeIndexStatsResponse, error)
+}
+
+var (
+	_ ControlPlane = &Client{}
+	_ DataPlane    = &Client{}
+)
+
+// Client is a long-lived Pinecone API client. The provider creates a single
+// *Client and hands it to every resource and data source, so the HTTP
+// connection pool, gRPC connections and limiter are shared between them.
+type Client struct {
+	apiKey      string
+	environment string
+	// Sends REST requests. Defaults to a client with its own connection pool.
+	HTTPClient *http.Client
+	// When set, data plane operations use gRPC instead of REST.
+	GrpcConns *GrpcConns
+	// When set, bounds the rate and concurrency of all requests.
+	Limiter *Limiter
+	// Tags applied to every taggable resource, ex. the provider's default_tags.
+	// Tags set on a resource take precedence.
+	DefaultTags map[string]string
+	// Prices used to estimate the cost of indexes. Defaults to the embedded price table.
+	Prices *PriceTable
+	// Estimated monthly cost increase above which planning an index warns. Zero disables the warning.
+	CostWarningThreshold float64
+	// When set, admin API operations, ex. managing API keys, are authenticated with it.
+	ServiceAccount *ServiceAccount
+	// When set, control and data plane requests are scoped to this project and
+	// authenticated with the ServiceAccount instead of the API key.
+	ProjectId string
+
+	cache    *responseCache
+	token    adminToken
+	apiUrl   string
+	adminUrl string
+	tokenUrl string
+}
+
+func NewClient(apiKey string, environment string) *Client {
+	return &Client{
+		apiKey:      apiKey,
+		environment: environment,
+		HTTPClient: &http.Client{
+			Transport: http.DefaultTransport.(*http.Transport).Clone(),
+		},
+		Prices:   DefaultPriceTable(),
+		cache:    newResponseCache(defaultCacheTTL),
+		apiUrl:   apiBaseUrl,
+		adminUrl: adminBaseUrl,
+		tokenUrl: tokenBaseUrl,
+	}
+}
+
+// Environment returns the environment the client is configured for, ex. us-west4-gcp-free
+func (c *Client) Environment() string {
+	return c.environment
+}
+
+// ApiKey returns the key the client authenticates with.
+func (c *Client) ApiKey() string {
+	return c.apiKey
+}
+
+// ErrNoApiKey is returned by control and data plane operations when the client
+// has neither an API key nor a project to authenticate with.
+var ErrNoApiKey = errors.New("no API key is configured, set apikey or scope the provider to a project with project_id")
+
+// authenticate adds the credentials of a control or data plane request.
+func (c *Client) authenticate(req *http.Request) error {
+	headers, err := c.credentials()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(headers); i += 2 {
+		req.Header.Add(headers[i], headers[i+1])
+	}
+	return nil
+}
+
+// credentials returns the headers, as name and value pairs, that authenticate
+// a REST or gRPC request: the API key, or the service account's access token
+// when the client is scoped to a project.
+func (c *Client) credentials() ([]string, error) {
+	if c.ProjectId == "" {
+		if c.apiKey == "" {
+			return nil, ErrNoApiKey
+		}
+		return []string{"Api-Key", c.apiKey}, nil
+	}
+
+	token, err := c.accessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{"Authorization", "Bearer " + token, "X-Project-Id", c.ProjectId}, nil
 }
 
 const (
 	baseUrl = "https://controller.%s.pinecone.io"
 )
 
+type WhoamiResponse struct {
+	// The project the API key belongs to. This is the project id used in index hosts.
+	ProjectName string `json:"project_name"`
+	// The label of the API key, ex. default
+	UserLabel string `json:"user_label"`
+	UserName  string `json:"user_name"`
+}
+
+// whoami
+// GET
+// https://controller.{environment}.pinecone.io/actions/whoami
+// Returns the project that the API key belongs to.
+//
+// 200 JSON - The project and API key details
+// 401 String - Unauthorized. The API key is invalid.
+func (c *Client) Whoami() (*WhoamiResponse, error) {
+	cached, generation, ok := c.cache.get("whoami")
+	if ok {
+		whoamiResponse := cached.(WhoamiResponse)
+		return &whoamiResponse, nil
+	}
+
+	url := fmt.Sprintf(baseUrl+"/actions/whoami", c.environment)
+
+	// initialize a request
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	// fire off the request
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyString := string(body)
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		// unmarshal json to struct
+		whoamiResponse := &WhoamiResponse{}
+		err := json.Unmarshal(body, whoamiResponse)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.set("whoami", generation, *whoamiResponse)
+		return whoamiResponse, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "Whoami", StatusCode: res.StatusCode, Message: bodyString}
+	}
+}
+
 // list_collections
 // GET
 // https://controller.{environment}.pinecone.io/collections
 // This operation returns a list of your Pinecone collections.
 //
 // 200 Array of String - This operation returns a list of all the collections in your current project.
+func (c *Client) ListCollections() ([]string, error) {
+	return c.list("ListCollections", "collections")
+}
+
+// list_indexes
+// GET
+// https://controller.{environment}.pinecone.io/databases
+// This operation returns a list of your Pinecone indexes.
+//
+// 200 Array of String - This operation returns a list of all the indexes that you have previously created, and which are associated with the given API key
+func (c *Client) ListIndexes() ([]string, error) {
+	return c.list("ListIndexes", "databases")
+}
+
+// list fetches one of the controller's list endpoints, which return an array
+// of names. Responses are cached until the next mutating call.
+func (c *Client) list(operation string, resource string) ([]string, error) {
+	cached, generation, ok := c.cache.get(resource)
+	if ok {
+		return append([]string{}, cached.([]string)...), nil
+	}
+
+	url := fmt.Sprintf(baseUrl+"/%s", c.environment, resource)
+
+	// initialize a request
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("accept", "application/json; charset=utf-8")
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	// fire off the request
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyString := string(body)
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		names := []string{}
+		err := json.Unmarshal(body, &names)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.set(resource, generation, names)
+		return append([]string{}, names...), nil
+	default: // non-2xx
+		return nil, &APIError{Operation: operation, StatusCode: res.StatusCode, Message: bodyString}
+	}
+}
 
 type CreateCollectionBodyParams struct {
 	// The name of the collection to be created.
@@ -40,8 +285,10 @@ type CreateCollectionBodyParams struct {
 // 400 String - Bad request. Request exceeds quota or collection name is invalid.
 // 409 String - A collection with the name provided already exists.
 // 500 String - Internal error. Can be caused by invalid parameters.
-func (p *Pinecone) CreateCollection(bodyParams CreateCollectionBodyParams) (*string, error) {
-	url := fmt.Sprintf(baseUrl+"/collections", p.Environment)
+func (c *Client) CreateCollection(bodyParams CreateCollectionBodyParams) (*string, error) {
+	defer c.cache.invalidate()
+
+	url := fmt.Sprintf(baseUrl+"/collections", c.environment)
 
 	// convert struct to byte[]
 	payloadBytes, err := json.Marshal(bodyParams)
@@ -60,10 +307,12 @@ func (p *Pinecone) CreateCollection(bodyParams CreateCollectionBodyParams) (*str
 
 	req.Header.Add("accept", "text/plain")
 	req.Header.Add("content-type", "application/json")
-	req.Header.Add("Api-Key", p.ApiKey)
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
 
 	// fire off the request
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +330,7 @@ func (p *Pinecone) CreateCollection(bodyParams CreateCollectionBodyParams) (*str
 	case res.StatusCode < 300: // 2xx
 		return &bodyString, nil
 	default: // non-2xx
-		return nil, fmt.Errorf("CreateCollection failed with status code %d and message %q", res.StatusCode, bodyString)
+		return nil, &APIError{Operation: "CreateCollection", StatusCode: res.StatusCode, Message: bodyString}
 	}
 }
 
@@ -103,8 +352,15 @@ type DescribeCollectionResponse struct {
 // 200 JSON - Configuration information and deployment status of the index
 // 404 String - Index not found.
 // 500 String - Internal error. Can be caused by invalid parameters.
-func (p *Pinecone) DescribeCollection(name string) (*DescribeCollectionResponse, error) {
-	url := fmt.Sprintf(baseUrl+"/collections/%s", p.Environment, name)
+func (c *Client) DescribeCollection(name string) (*DescribeCollectionResponse, error) {
+	cacheKey := "collections/" + name
+	cached, generation, ok := c.cache.get(cacheKey)
+	if ok {
+		descCollectionResponse := cached.(DescribeCollectionResponse)
+		return &descCollectionResponse, nil
+	}
+
+	url := fmt.Sprintf(baseUrl+"/collections/%s", c.environment, name)
 
 	// initialize a request
 	req, err := http.NewRequest("GET", url, nil)
@@ -113,10 +369,12 @@ func (p *Pinecone) DescribeCollection(name string) (*DescribeCollectionResponse,
 	}
 
 	req.Header.Add("accept", "application/json")
-	req.Header.Add("Api-Key", p.ApiKey)
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
 
 	// fire off the request
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -137,9 +395,14 @@ func (p *Pinecone) DescribeCollection(name string) (*DescribeCollectionResponse,
 		if err != nil {
 			return nil, err
 		}
+		// collections that are still being created are polled, so only
+		// cache them once they are ready
+		if descCollectionResponse.Status == "Ready" {
+			c.cache.set(cacheKey, generation, *descCollectionResponse)
+		}
 		return descCollectionResponse, nil
 	default: // non-2xx
-		return nil, fmt.Errorf("DescribeCollection failed with status code %d and message %q", res.StatusCode, bodyString)
+		return nil, &APIError{Operation: "DescribeCollection", StatusCode: res.StatusCode, Message: bodyString}
 	}
 }
 
@@ -151,8 +414,10 @@ func (p *Pinecone) DescribeCollection(name string) (*DescribeCollectionResponse,
 // 202 String - The index has been successfully deleted.
 // 404 String - Collection not found.
 // 500 String - Internal error. Can be caused by invalid parameters.
-func (p *Pinecone) DeleteCollection(name string) (*string, error) {
-	url := fmt.Sprintf(baseUrl+"/collections/%s", p.Environment, name)
+func (c *Client) DeleteCollection(name string) (*string, error) {
+	defer c.cache.invalidate()
+
+	url := fmt.Sprintf(baseUrl+"/collections/%s", c.environment, name)
 
 	// initialize a request
 	req, err := http.NewRequest("DELETE", url, nil)
@@ -161,9 +426,11 @@ func (p *Pinecone) DeleteCollection(name string) (*string, error) {
 	}
 
 	req.Header.Add("accept", "application/json")
-	req.Header.Add("Api-Key", p.ApiKey)
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +447,7 @@ func (p *Pinecone) DeleteCollection(name string) (*string, error) {
 	case res.StatusCode < 300: // 2xx
 		return &bodyString, nil
 	default: // non-2xx
-		return nil, fmt.Errorf("DeleteCollection failed with status code %d and message %q", res.StatusCode, bodyString)
+		return nil, &APIError{Operation: "DeleteCollection", StatusCode: res.StatusCode, Message: bodyString}
 	}
 }
 
@@ -201,14 +468,18 @@ type CreateIndexBodyParams struct {
 	MetadataConfig *map[string]interface{} `json:"metadata_config"`
 	// The name of the collection to create an index from
 	SourceCollection string `json:"source_collection"`
+	// Custom key-value pairs attached to the index. Ignored by environments without tag support.
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // create_index
 // POST
 // https://controller.{environment}.pinecone.io/databases
 // This operation creates a Pinecone index. You can use it to specify the measure of similarity, the dimension of vectors to be stored in the index, the numbers of replicas to use, and more.
-func (p *Pinecone) CreateIndex(data CreateIndexBodyParams) (*string, error) {
-	url := fmt.Sprintf(baseUrl+"/databases", p.Environment)
+func (c *Client) CreateIndex(data CreateIndexBodyParams) (*string, error) {
+	defer c.cache.invalidate()
+
+	url := fmt.Sprintf(baseUrl+"/databases", c.environment)
 
 	// set default values
 	if data.Metric == "" {
@@ -241,10 +512,12 @@ func (p *Pinecone) CreateIndex(data CreateIndexBodyParams) (*string, error) {
 
 	req.Header.Add("accept", "text/plain")
 	req.Header.Add("content-type", "application/json")
-	req.Header.Add("Api-Key", p.ApiKey)
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
 
 	// fire off the request
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -262,7 +535,7 @@ func (p *Pinecone) CreateIndex(data CreateIndexBodyParams) (*string, error) {
 	case res.StatusCode < 300: // 2xx
 		return &bodyString, nil
 	default: // non-2xx
-		return nil, fmt.Errorf("CreateIndex failed with status code %d and message %q", res.StatusCode, bodyString)
+		return nil, &APIError{Operation: "CreateIndex", StatusCode: res.StatusCode, Message: bodyString}
 	}
 }
 
@@ -274,6 +547,11 @@ type DescribeIndexResponse struct {
 		Replicas  int64  `json:"replicas"`
 		Shards    int64  `json:"shards"`
 		Pods      int64  `json:"pods"`
+		PodType   string `json:"pod_type"`
+		// values: enabled, disabled. Empty if the environment does not support deletion protection.
+		DeletionProtection string `json:"deletion_protection"`
+		// Nil if the environment does not support tags, or the index has none.
+		Tags map[string]string `json:"tags"`
 	} `json:"database"`
 	Status struct {
 		Waiting []interface{} `json:"waiting"`
@@ -290,11 +568,18 @@ type DescribeIndexResponse struct {
 // GET
 // https://controller.{environment}.pinecone.io/databases/{indexName}
 // Get a description of an index.
-func (p *Pinecone) DescribeIndex(name string) (*DescribeIndexResponse, error) {
+func (c *Client) DescribeIndex(name string) (*DescribeIndexResponse, error) {
 	if name == "" {
 		return nil, fmt.Errorf("DescribeIndex failed: name argument was not specified")
 	}
-	url := fmt.Sprintf(baseUrl+"/databases/%s", p.Environment, name)
+	cacheKey := "databases/" + name
+	cached, generation, ok := c.cache.get(cacheKey)
+	if ok {
+		descIndexResponse := cached.(DescribeIndexResponse)
+		return &descIndexResponse, nil
+	}
+
+	url := fmt.Sprintf(baseUrl+"/databases/%s", c.environment, name)
 
 	// initialize a request
 	req, err := http.NewRequest("GET", url, nil)
@@ -303,10 +588,12 @@ func (p *Pinecone) DescribeIndex(name string) (*DescribeIndexResponse, error) {
 	}
 
 	req.Header.Add("accept", "application/json")
-	req.Header.Add("Api-Key", p.ApiKey)
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
 
 	// fire off the request
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -327,17 +614,27 @@ func (p *Pinecone) DescribeIndex(name string) (*DescribeIndexResponse, error) {
 		if err != nil {
 			return nil, err
 		}
+		// indexes that are still initializing are polled, so only cache
+		// them once they are ready
+		if descIndexResponse.Status.Ready {
+			c.cache.set(cacheKey, generation, *descIndexResponse)
+		}
 		return descIndexResponse, nil
 	default: // non-2xx
-		return nil, fmt.Errorf("DescribeIndex failed with status code %d and message %q", res.StatusCode, bodyString)
+		return nil, &APIError{Operation: "DescribeIndex", StatusCode: res.StatusCode, Message: bodyString}
 	}
 }
 
 type ConfigureIndexRequest struct {
 	// The new pod type for the index. One of s1, p1, or p2 appended with . and one of x1, x2, x4, or x8.
-	PodType string `json:"pod_type"`
+	PodType string `json:"pod_type,omitempty"`
 	// The desired number of replicas for the index.
-	Replicas int64 `json:"replicas"`
+	Replicas int64 `json:"replicas,omitempty"`
+	// Whether the index can be deleted. One of enabled or disabled.
+	// Only send this to environments that report deletion_protection in describe_index.
+	DeletionProtection string `json:"deletion_protection,omitempty"`
+	// Tags to add or change. A tag is removed by setting its value to "".
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // configure_index
@@ -350,8 +647,10 @@ type ConfigureIndexRequest struct {
 // 400 String - Bad request,not enough quota.
 // 404 String - Index not found.
 // 500 String - Internal error. Can be caused by invalid parameters.
-func (p *Pinecone) ConfigureIndex(name string, data *ConfigureIndexRequest) (*string, error) {
-	url := fmt.Sprintf(baseUrl+"/databases/%s", p.Environment, name)
+func (c *Client) ConfigureIndex(name string, data *ConfigureIndexRequest) (*string, error) {
+	defer c.cache.invalidate()
+
+	url := fmt.Sprintf(baseUrl+"/databases/%s", c.environment, name)
 
 	payloadBytes, err := json.Marshal(data)
 	if err != nil {
@@ -369,10 +668,12 @@ func (p *Pinecone) ConfigureIndex(name string, data *ConfigureIndexRequest) (*st
 
 	req.Header.Add("accept", "application/json")
 	req.Header.Add("content-type", "application/json")
-	req.Header.Add("Api-Key", p.ApiKey)
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
 
 	// fire off the request
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -389,7 +690,7 @@ func (p *Pinecone) ConfigureIndex(name string, data *ConfigureIndexRequest) (*st
 	case res.StatusCode < 300: // 2xx
 		return &bodyString, nil
 	default: // non-2xx
-		return nil, fmt.Errorf("ConfigureIndex failed with status code %d and message %q", res.StatusCode, bodyString)
+		return nil, &APIError{Operation: "ConfigureIndex", StatusCode: res.StatusCode, Message: bodyString}
 	}
 }
 
@@ -401,8 +702,10 @@ func (p *Pinecone) ConfigureIndex(name string, data *ConfigureIndexRequest) (*st
 // 202 String - The index has been successfully deleted
 // 404 String - Index not found.
 // 500 String - Internal error. Can be caused by invalid parameters.
-func (p *Pinecone) DeleteIndex(name string) (*string, error) {
-	url := fmt.Sprintf(baseUrl+"/databases/%s", p.Environment, name)
+func (c *Client) DeleteIndex(name string) (*string, error) {
+	defer c.cache.invalidate()
+
+	url := fmt.Sprintf(baseUrl+"/databases/%s", c.environment, name)
 
 	// initialize a request
 	req, err := http.NewRequest("DELETE", url, nil)
@@ -411,9 +714,11 @@ func (p *Pinecone) DeleteIndex(name string) (*string, error) {
 	}
 
 	req.Header.Add("accept", "application/json")
-	req.Header.Add("Api-Key", p.ApiKey)
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -430,6 +735,6 @@ func (p *Pinecone) DeleteIndex(name string) (*string, error) {
 	case res.StatusCode < 300: // 2xx
 		return &bodyString, nil
 	default: // non-2xx
-		return nil, fmt.Errorf("DeleteIndex failed with status code %d and message %q", res.StatusCode, bodyString)
+		return nil, &APIError{Operation: "DeleteIndex", StatusCode: res.StatusCode, Message: bodyString}
 	}
 }
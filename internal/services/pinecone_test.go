@@ -0,0 +1,31 @@
+package pinecone
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWhoami(t *testing.T) {
+	var calls int64
+	c := newTestController(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&calls, 1)
+		if r.URL.Path != "/actions/whoami" || r.Header.Get("Api-Key") != "key" {
+			t.Errorf("unexpected request %s %v", r.URL.Path, r.Header)
+		}
+		w.Write([]byte(`{"project_name":"abc1234","user_label":"default","user_name":"u-1"}`))
+	})
+
+	for i := 0; i < 2; i++ {
+		whoami, err := c.Whoami()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if whoami.ProjectName != "abc1234" || whoami.UserLabel != "default" {
+			t.Errorf("unexpected response %+v", whoami)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected whoami to be cached, got %d calls", calls)
+	}
+}
@@ -0,0 +1,74 @@
+package pinecone
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+// The list prices of pods on the Standard plan, in USD per pod per hour.
+// Bump the version whenever the prices change.
+//
+//go:embed prices.json
+var defaultPrices []byte
+
+// PriceTable is the hourly price of each pod type, used to estimate the cost
+// of pod-based indexes.
+type PriceTable struct {
+	// ex. 2023-10-01, suffixed with +overrides when prices were overridden
+	Version  string `json:"version"`
+	Currency string `json:"currency"`
+	// Hours billed per month, ex. 730
+	HoursPerMonth   float64            `json:"hours_per_month"`
+	PodHourlyPrices map[string]float64 `json:"pod_hourly_prices"`
+}
+
+// DefaultPriceTable returns the price table embedded in the provider.
+func DefaultPriceTable() *PriceTable {
+	table := &PriceTable{}
+	if err := json.Unmarshal(defaultPrices, table); err != nil {
+		panic(fmt.Sprintf("invalid embedded price table: %s", err))
+	}
+	return table
+}
+
+// WithOverrides returns a copy of the table with the given pod prices, ex.
+// negotiated prices, replacing or adding to the list prices.
+func (t *PriceTable) WithOverrides(podHourlyPrices map[string]float64) *PriceTable {
+	if len(podHourlyPrices) == 0 {
+		return t
+	}
+
+	overridden := &PriceTable{
+		Version:         t.Version + "+overrides",
+		Currency:        t.Currency,
+		HoursPerMonth:   t.HoursPerMonth,
+		PodHourlyPrices: map[string]float64{},
+	}
+	for podType, price := range t.PodHourlyPrices {
+		overridden.PodHourlyPrices[podType] = price
+	}
+	for podType, price := range podHourlyPrices {
+		overridden.PodHourlyPrices[podType] = price
+	}
+	return overridden
+}
+
+// HourlyCost returns the hourly price of running pods pods of podType.
+// Pods include replicas.
+func (t *PriceTable) HourlyCost(podType string, pods int64) (float64, error) {
+	price, ok := t.PodHourlyPrices[podType]
+	if !ok {
+		return 0, fmt.Errorf("no price for pod type %q in price table %s", podType, t.Version)
+	}
+	return price * float64(pods), nil
+}
+
+// MonthlyCost returns the monthly price of running pods pods of podType.
+func (t *PriceTable) MonthlyCost(podType string, pods int64) (float64, error) {
+	hourly, err := t.HourlyCost(podType, pods)
+	if err != nil {
+		return 0, err
+	}
+	return hourly * t.HoursPerMonth, nil
+}
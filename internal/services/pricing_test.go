@@ -0,0 +1,33 @@
+package pinecone
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPriceTable(t *testing.T) {
+	table := DefaultPriceTable()
+
+	monthly, err := table.MonthlyCost("p1.x2", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 0.192 * 3 * 730; math.Abs(monthly-want) > 1e-9 {
+		t.Errorf("expected %v, got %v", want, monthly)
+	}
+
+	if _, err := table.MonthlyCost("p3.x1", 1); err == nil {
+		t.Errorf("expected an error for an unknown pod type")
+	}
+
+	overridden := table.WithOverrides(map[string]float64{"p1.x2": 0.1})
+	if overridden.Version != table.Version+"+overrides" {
+		t.Errorf("unexpected version %q", overridden.Version)
+	}
+	if hourly, _ := overridden.HourlyCost("p1.x2", 2); hourly != 0.2 {
+		t.Errorf("expected the overridden price, got %v", hourly)
+	}
+	if table.PodHourlyPrices["p1.x2"] != 0.192 {
+		t.Errorf("expected the default table to be unchanged")
+	}
+}
@@ -0,0 +1,166 @@
+package pinecone
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ServerlessPlane is the set of global control plane operations on
+// serverless indexes, ie. indexes restored from a backup or bound to an
+// embedding model, which the legacy controller does not manage. It is
+// satisfied by *Client, and by fakes in unit tests.
+type ServerlessPlane interface {
+	DescribeIndexModel(name string) (*IndexModel, error)
+	ConfigureIndexModel(name string, data ConfigureIndexModelRequest) (*IndexModel, error)
+	DeleteIndexModel(name string) error
+}
+
+var _ ServerlessPlane = &Client{}
+
+// IndexModel is an index as described by the global control plane.
+type IndexModel struct {
+	Name      string `json:"name"`
+	Metric    string `json:"metric"`
+	Dimension int64  `json:"dimension"`
+	Host      string `json:"host"`
+	// values: enabled, disabled
+	DeletionProtection string            `json:"deletion_protection"`
+	Tags               map[string]string `json:"tags"`
+	// Nil unless the index has integrated embedding.
+	Embed  *IndexEmbed `json:"embed"`
+	Status struct {
+		Ready bool `json:"ready"`
+		// values: Initializing, Ready, ...
+		State string `json:"state"`
+	} `json:"status"`
+}
+
+type ConfigureIndexModelRequest struct {
+	// values: enabled, disabled
+	DeletionProtection string `json:"deletion_protection,omitempty"`
+	// Tags to add or change. A tag is removed by setting its value to "".
+	Tags map[string]string `json:"tags,omitempty"`
+	// The field map and parameters of an index with integrated embedding. The
+	// model cannot be changed.
+	Embed *IndexEmbed `json:"embed,omitempty"`
+}
+
+// describe_index
+// GET
+// https://api.pinecone.io/indexes/{index_name}
+// This operation returns an index, including its embedding configuration.
+//
+// 200 JSON - The index
+// 404 JSON - Index not found.
+func (c *Client) DescribeIndexModel(name string) (*IndexModel, error) {
+	req, err := c.newApiRequest("GET", fmt.Sprintf("/indexes/%s", name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		index := &IndexModel{}
+		err := json.Unmarshal(body, index)
+		if err != nil {
+			return nil, err
+		}
+		return index, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "DescribeIndexModel", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// configure_index
+// PATCH
+// https://api.pinecone.io/indexes/{index_name}
+// This operation changes the deletion protection or tags of a serverless
+// index, or the embedding configuration of an index with integrated embedding.
+//
+// 202 JSON - The index
+// 400 JSON - Bad request, ex. a different model.
+// 404 JSON - Index not found.
+func (c *Client) ConfigureIndexModel(name string, data ConfigureIndexModelRequest) (*IndexModel, error) {
+	defer c.cache.invalidate()
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newApiRequest("PATCH", fmt.Sprintf("/indexes/%s", name), bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		index := &IndexModel{}
+		err := json.Unmarshal(body, index)
+		if err != nil {
+			return nil, err
+		}
+		return index, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "ConfigureIndexModel", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
+
+// delete_index
+// DELETE
+// https://api.pinecone.io/indexes/{index_name}
+// This operation deletes a serverless index.
+//
+// 202 - The index is being deleted
+// 403 JSON - Deletion protection is enabled.
+// 404 JSON - Index not found.
+func (c *Client) DeleteIndexModel(name string) error {
+	defer c.cache.invalidate()
+
+	req, err := c.newApiRequest("DELETE", fmt.Sprintf("/indexes/%s", name), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		return nil
+	default: // non-2xx
+		return &APIError{Operation: "DeleteIndexModel", StatusCode: res.StatusCode, Message: string(body)}
+	}
+}
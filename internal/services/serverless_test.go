@@ -0,0 +1,66 @@
+package pinecone
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServerlessIndexLifecycle(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"name":"movies","metric":"dotproduct","dimension":1024,"host":"movies-abc1234.svc.aped-4627-b74a.pinecone.io","deletion_protection":"disabled","status":{"ready":true,"state":"Ready"}}`))
+		case "PATCH":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"name":"movies","deletion_protection":"enabled","tags":{"team":"search"}}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.apiUrl = srv.URL
+
+	index, err := c.DescribeIndexModel("movies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index.Host != "movies-abc1234.svc.aped-4627-b74a.pinecone.io" || !index.Status.Ready || index.Metric != "dotproduct" {
+		t.Errorf("unexpected index %+v", index)
+	}
+
+	configured, err := c.ConfigureIndexModel("movies", ConfigureIndexModelRequest{DeletionProtection: "enabled", Tags: map[string]string{"team": "search"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if configured.DeletionProtection != "enabled" {
+		t.Errorf("unexpected index %+v", configured)
+	}
+
+	_, err = c.ConfigureIndexModel("movies", ConfigureIndexModelRequest{Embed: &IndexEmbed{FieldMap: map[string]string{"text": "summary"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.DeleteIndexModel("movies"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"GET /indexes/movies ",
+		`PATCH /indexes/movies {"deletion_protection":"enabled","tags":{"team":"search"}}`,
+		`PATCH /indexes/movies {"embed":{"field_map":{"text":"summary"}}}`,
+		"DELETE /indexes/movies ",
+	}
+	for i, request := range want {
+		if i >= len(requests) || requests[i] != request {
+			t.Errorf("expected request %q, got %v", request, requests)
+		}
+	}
+}
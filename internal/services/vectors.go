@@ -0,0 +1,430 @@
+package pinecone
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Data plane operations are sent to the index host rather than the controller.
+// The host is reported by DescribeIndex, ex. {index}-{project}.svc.{environment}.pinecone.io
+const (
+	dataPlaneUrl = "https://%s"
+)
+
+// SparseValues holds the non-zero entries of a sparse vector. Indices and
+// Values must have the same length.
+type SparseValues struct {
+	Indices []uint32  `json:"indices"`
+	Values  []float32 `json:"values"`
+}
+
+type Vector struct {
+	// This is the vector's unique id.
+	Id string `json:"id"`
+	// This is the vector data included in the request.
+	Values []float32 `json:"values"`
+	// Vector sparse data. Represented as a list of indices and a list of corresponding values, which must be the same length.
+	SparseValues *SparseValues `json:"sparseValues,omitempty"`
+	// This is the metadata included in the request.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ValidateSparseValues checks that sparse values are well formed and are only
+// used with an index whose metric supports them. Pinecone only accepts sparse
+// values on dotproduct indexes.
+func ValidateSparseValues(metric string, sparseValues *SparseValues) error {
+	if sparseValues == nil {
+		return nil
+	}
+	if metric != "dotproduct" {
+		return fmt.Errorf("sparse values are only supported by indexes using the dotproduct metric, got %q", metric)
+	}
+	if len(sparseValues.Indices) != len(sparseValues.Values) {
+		return fmt.Errorf("sparse values must have the same number of indices and values, got %d indices and %d values", len(sparseValues.Indices), len(sparseValues.Values))
+	}
+	seen := make(map[uint32]bool, len(sparseValues.Indices))
+	for _, i := range sparseValues.Indices {
+		if seen[i] {
+			return fmt.Errorf("sparse values contain duplicate index %d", i)
+		}
+		seen[i] = true
+	}
+	return nil
+}
+
+type UpsertRequest struct {
+	// An array containing the vectors to upsert. Recommended batch limit is 100 vectors.
+	Vectors []Vector `json:"vectors"`
+	// This is the namespace name where you upsert vectors.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type UpsertResponse struct {
+	UpsertedCount int64 `json:"upsertedCount"`
+}
+
+// upsert
+// POST
+// https://{index_host}/vectors/upsert
+// The Upsert operation writes vectors into a namespace. If a new value is upserted for an existing vector id, it will overwrite the previous value.
+//
+// 200 JSON - A successful response.
+// 400 String - Bad request. Ex. sparse values on a non-dotproduct index.
+func (c *Client) Upsert(host string, data UpsertRequest) (*UpsertResponse, error) {
+	if c.GrpcConns != nil {
+		return c.upsertGrpc(host, data)
+	}
+
+	url := fmt.Sprintf(dataPlaneUrl+"/vectors/upsert", host)
+
+	// convert struct to byte[]
+	payloadBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// convert byte[] to io.Reader
+	payload := bytes.NewReader(payloadBytes)
+
+	// initialize a request
+	req, err := http.NewRequest("POST", url, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("content-type", "application/json")
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	// fire off the request
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyString := string(body)
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		upsertResponse := &UpsertResponse{}
+		err := json.Unmarshal(body, upsertResponse)
+		if err != nil {
+			return nil, err
+		}
+		return upsertResponse, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "Upsert", StatusCode: res.StatusCode, Message: bodyString}
+	}
+}
+
+type QueryRequest struct {
+	// The namespace to query.
+	Namespace string `json:"namespace,omitempty"`
+	// The number of results to return for each query.
+	TopK int64 `json:"topK"`
+	// The filter to apply. You can use vector metadata to limit your search.
+	Filter map[string]interface{} `json:"filter,omitempty"`
+	// Indicates whether vector values are included in the response.
+	IncludeValues bool `json:"includeValues"`
+	// Indicates whether metadata is included in the response as well as the ids.
+	IncludeMetadata bool `json:"includeMetadata"`
+	// The query vector. This should be the same length as the dimension of the index being queried.
+	Vector []float32 `json:"vector,omitempty"`
+	// The sparse half of a hybrid query. Only supported by dotproduct indexes.
+	SparseVector *SparseValues `json:"sparseVector,omitempty"`
+	// The unique ID of the vector to be used as a query vector. Each query can contain only one of vector or id.
+	Id string `json:"id,omitempty"`
+}
+
+type QueryMatch struct {
+	Id           string                 `json:"id"`
+	Score        float32                `json:"score"`
+	Values       []float32              `json:"values"`
+	SparseValues *SparseValues          `json:"sparseValues,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type QueryResponse struct {
+	Matches   []QueryMatch `json:"matches"`
+	Namespace string       `json:"namespace"`
+}
+
+// query
+// POST
+// https://{index_host}/query
+// The Query operation searches a namespace, using a query vector. It retrieves the ids of the most similar items in a namespace, along with their similarity scores.
+// Hybrid queries send both a dense vector and a sparseVector.
+//
+// 200 JSON - A successful response.
+// 400 String - Bad request. Ex. sparse values on a non-dotproduct index.
+func (c *Client) Query(host string, data QueryRequest) (*QueryResponse, error) {
+	if c.GrpcConns != nil {
+		return c.queryGrpc(host, data)
+	}
+
+	url := fmt.Sprintf(dataPlaneUrl+"/query", host)
+
+	// convert struct to byte[]
+	payloadBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// convert byte[] to io.Reader
+	payload := bytes.NewReader(payloadBytes)
+
+	// initialize a request
+	req, err := http.NewRequest("POST", url, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("content-type", "application/json")
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	// fire off the request
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyString := string(body)
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		queryResponse := &QueryResponse{}
+		err := json.Unmarshal(body, queryResponse)
+		if err != nil {
+			return nil, err
+		}
+		return queryResponse, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "Query", StatusCode: res.StatusCode, Message: bodyString}
+	}
+}
+
+type FetchResponse struct {
+	Vectors   map[string]Vector `json:"vectors"`
+	Namespace string            `json:"namespace"`
+}
+
+// fetch
+// GET
+// https://{index_host}/vectors/fetch
+// The Fetch operation looks up and returns vectors, by ID, from a single namespace. The returned vectors include the vector data and/or metadata.
+//
+// 200 JSON - A successful response.
+func (c *Client) Fetch(host string, namespace string, ids []string) (*FetchResponse, error) {
+	if c.GrpcConns != nil {
+		return c.fetchGrpc(host, namespace, ids)
+	}
+
+	query := url.Values{}
+	for _, id := range ids {
+		query.Add("ids", id)
+	}
+	if namespace != "" {
+		query.Set("namespace", namespace)
+	}
+	url := fmt.Sprintf(dataPlaneUrl+"/vectors/fetch?%s", host, query.Encode())
+
+	// initialize a request
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	// fire off the request
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyString := string(body)
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		fetchResponse := &FetchResponse{}
+		err := json.Unmarshal(body, fetchResponse)
+		if err != nil {
+			return nil, err
+		}
+		return fetchResponse, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "Fetch", StatusCode: res.StatusCode, Message: bodyString}
+	}
+}
+
+type DeleteVectorsRequest struct {
+	// Vectors to delete.
+	Ids []string `json:"ids,omitempty"`
+	// This indicates that all vectors in the index namespace should be deleted.
+	DeleteAll bool `json:"deleteAll,omitempty"`
+	// The namespace to delete vectors from, if applicable.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// delete
+// POST
+// https://{index_host}/vectors/delete
+// The Delete operation deletes vectors, by id, from a single namespace.
+//
+// 200 JSON - A successful response.
+func (c *Client) DeleteVectors(host string, data DeleteVectorsRequest) error {
+	if c.GrpcConns != nil {
+		return c.deleteVectorsGrpc(host, data)
+	}
+
+	url := fmt.Sprintf(dataPlaneUrl+"/vectors/delete", host)
+
+	// convert struct to byte[]
+	payloadBytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	// convert byte[] to io.Reader
+	payload := bytes.NewReader(payloadBytes)
+
+	// initialize a request
+	req, err := http.NewRequest("POST", url, payload)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("content-type", "application/json")
+	if err := c.authenticate(req); err != nil {
+		return err
+	}
+
+	// fire off the request
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	bodyString := string(body)
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		return nil
+	default: // non-2xx
+		return &APIError{Operation: "DeleteVectors", StatusCode: res.StatusCode, Message: bodyString}
+	}
+}
+
+type DescribeIndexStatsRequest struct {
+	// If this parameter is present, the operation only returns statistics for vectors that satisfy the filter.
+	Filter map[string]interface{} `json:"filter,omitempty"`
+}
+
+type NamespaceSummary struct {
+	VectorCount int64 `json:"vectorCount"`
+}
+
+type DescribeIndexStatsResponse struct {
+	Namespaces       map[string]NamespaceSummary `json:"namespaces"`
+	Dimension        int64                       `json:"dimension"`
+	IndexFullness    float32                     `json:"indexFullness"`
+	TotalVectorCount int64                       `json:"totalVectorCount"`
+}
+
+// describe_index_stats
+// POST
+// https://{index_host}/describe_index_stats
+// The DescribeIndexStats operation returns statistics about the index's contents, including the vector count per namespace and the number of dimensions.
+//
+// 200 JSON - A successful response.
+func (c *Client) DescribeIndexStats(host string, data DescribeIndexStatsRequest) (*DescribeIndexStatsResponse, error) {
+	if c.GrpcConns != nil {
+		return c.describeIndexStatsGrpc(host, data)
+	}
+
+	url := fmt.Sprintf(dataPlaneUrl+"/describe_index_stats", host)
+
+	// convert struct to byte[]
+	payloadBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// convert byte[] to io.Reader
+	payload := bytes.NewReader(payloadBytes)
+
+	// initialize a request
+	req, err := http.NewRequest("POST", url, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("content-type", "application/json")
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	// fire off the request
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyString := string(body)
+
+	switch {
+	case res.StatusCode < 300: // 2xx
+		statsResponse := &DescribeIndexStatsResponse{}
+		err := json.Unmarshal(body, statsResponse)
+		if err != nil {
+			return nil, err
+		}
+		return statsResponse, nil
+	default: // non-2xx
+		return nil, &APIError{Operation: "DescribeIndexStats", StatusCode: res.StatusCode, Message: bodyString}
+	}
+}
@@ -0,0 +1,93 @@
+package pinecone
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateSparseValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		metric  string
+		sparse  *SparseValues
+		wantErr string
+	}{
+		{"no sparse values", "cosine", nil, ""},
+		{"dotproduct", "dotproduct", &SparseValues{Indices: []uint32{1, 5}, Values: []float32{0.5, 0.25}}, ""},
+		{"cosine", "cosine", &SparseValues{Indices: []uint32{1}, Values: []float32{0.5}}, "dotproduct"},
+		{"length mismatch", "dotproduct", &SparseValues{Indices: []uint32{1, 2}, Values: []float32{0.5}}, "same number"},
+		{"duplicate index", "dotproduct", &SparseValues{Indices: []uint32{3, 3}, Values: []float32{0.5, 0.5}}, "duplicate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSparseValues(tt.metric, tt.sparse)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestQuerySparseVector(t *testing.T) {
+	var got map[string]interface{}
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/query" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &got)
+		w.Write([]byte(`{"matches":[{"id":"a","score":0.9,"values":[],"sparseValues":{"indices":[7],"values":[0.3]}}],"namespace":"ns"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.HTTPClient = srv.Client()
+	res, err := c.Query(strings.TrimPrefix(srv.URL, "https://"), QueryRequest{
+		Namespace:    "ns",
+		TopK:         1,
+		Vector:       []float32{0.1, 0.2},
+		SparseVector: &SparseValues{Indices: []uint32{7}, Values: []float32{0.3}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := got["sparseVector"]; !ok {
+		t.Errorf("expected sparseVector in request body, got %v", got)
+	}
+	if len(res.Matches) != 1 || res.Matches[0].SparseValues == nil || res.Matches[0].SparseValues.Indices[0] != 7 {
+		t.Errorf("unexpected matches %+v", res.Matches)
+	}
+}
+
+func TestDescribeIndexStats(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/describe_index_stats" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"namespaces":{"":{"vectorCount":3},"ns":{"vectorCount":7}},"dimension":8,"indexFullness":0,"totalVectorCount":10}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", "test")
+	c.HTTPClient = srv.Client()
+	res, err := c.DescribeIndexStats(strings.TrimPrefix(srv.URL, "https://"), DescribeIndexStatsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.TotalVectorCount != 10 || res.Namespaces["ns"].VectorCount != 7 {
+		t.Errorf("unexpected stats %+v", res)
+	}
+}